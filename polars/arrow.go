@@ -0,0 +1,202 @@
+package polars
+
+/*
+#include "firn.h"
+
+// CGO cannot call C function pointers directly, so the Arrow stream
+// callbacks are invoked through these small trampolines.
+static int firn_stream_get_schema(struct ArrowArrayStream* stream, struct ArrowSchema* out) {
+    return stream->get_schema(stream, out);
+}
+
+static int firn_stream_get_next(struct ArrowArrayStream* stream, struct ArrowArray* out) {
+    return stream->get_next(stream, out);
+}
+
+static const char* firn_stream_last_error(struct ArrowArrayStream* stream) {
+    return stream->get_last_error(stream);
+}
+
+static void firn_stream_release(struct ArrowArrayStream* stream) {
+    if (stream->release != NULL) {
+        stream->release(stream);
+    }
+}
+
+static void firn_schema_release(struct ArrowSchema* schema) {
+    if (schema->release != NULL) {
+        schema->release(schema);
+    }
+}
+
+static void firn_array_release(struct ArrowArray* array) {
+    if (array->release != NULL) {
+        array->release(array);
+    }
+}
+*/
+import "C"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// ArrowBatch is a single Arrow record batch exported through the Arrow C Data Interface
+// The schema describes a struct whose children are the DataFrame columns.
+// Ownership: the batch owns its C structs until Release() is called, or until the
+// pointers are handed to an importer (e.g. arrow/cdata.ImportCRecordBatch), which
+// takes over the release callbacks.
+type ArrowBatch struct {
+	schema *C.struct_ArrowSchema
+	array  *C.struct_ArrowArray
+	Err    error // Non-nil if the stream failed while producing this batch
+}
+
+// NumRows returns the number of rows in the batch
+func (b ArrowBatch) NumRows() int {
+	if b.array == nil {
+		return 0
+	}
+	return int(b.array.length)
+}
+
+// SchemaPtr returns a pointer to the C ArrowSchema for use with Arrow importers
+func (b ArrowBatch) SchemaPtr() unsafe.Pointer {
+	return unsafe.Pointer(b.schema)
+}
+
+// ArrayPtr returns a pointer to the C ArrowArray for use with Arrow importers
+func (b ArrowBatch) ArrayPtr() unsafe.Pointer {
+	return unsafe.Pointer(b.array)
+}
+
+// Release frees the batch's Arrow buffers (no-op if already released or imported)
+func (b *ArrowBatch) Release() {
+	if b.array != nil {
+		C.firn_array_release(b.array)
+		C.free(unsafe.Pointer(b.array))
+		b.array = nil
+	}
+	if b.schema != nil {
+		C.firn_schema_release(b.schema)
+		C.free(unsafe.Pointer(b.schema))
+		b.schema = nil
+	}
+}
+
+// ToArrowRecord exports an executed DataFrame as one Arrow record batch through the
+// Arrow C Data Interface, without serializing the data
+// The batch's schema and array are ready for arrow/cdata.ImportCRecordBatch from the Apache
+// Arrow Go library. The exported buffers share ownership of the column data with Rust, so
+// releasing the DataFrame afterwards does not invalidate the batch; the data is freed once
+// the batch (or the record imported from it) is released.
+// Example: batch, err := result.ToArrowRecord(); rec, err := cdata.ImportCRecordBatch(
+// (*cdata.CArrowArray)(batch.ArrayPtr()), (*cdata.CArrowSchema)(batch.SchemaPtr()))
+func (df *DataFrame) ToArrowRecord() (ArrowBatch, error) {
+	if df.handle.handle == 0 {
+		return ArrowBatch{}, errors.New("DataFrame must be executed before calling ToArrowRecord()")
+	}
+
+	schema := (*C.struct_ArrowSchema)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowSchema{}))))
+	array := (*C.struct_ArrowArray)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowArray{}))))
+	if rc := C.dataframe_to_arrow_array(df.handle.handle, schema, array); rc != 0 {
+		(&ArrowBatch{schema: schema, array: array}).Release()
+		return ArrowBatch{}, errors.New("failed to export dataframe as Arrow array")
+	}
+	return ArrowBatch{schema: schema, array: array}, nil
+}
+
+// CollectArrowBatches collects the DataFrame and streams the result as Arrow record
+// batches of at most batchSize rows, backed by the Arrow C Stream Interface
+// The channel is closed after the last batch, and the consumer must drain it; use
+// CollectArrowBatchesCtx to stop early. Callers must Release() each batch they receive.
+// Example: batches, err := df.CollectArrowBatches(1024); for b := range batches { ...; b.Release() }
+func (df *DataFrame) CollectArrowBatches(batchSize int) (<-chan ArrowBatch, error) {
+	return df.CollectArrowBatchesCtx(context.Background(), batchSize)
+}
+
+// CollectArrowBatchesCtx is CollectArrowBatches with early stop: cancelling ctx ends the
+// stream and closes the channel, after at most one more in-flight batch
+// The stream holds its own reference to the collected data, so df stays valid and must
+// still be released by the caller, even while batches are being read.
+func (df *DataFrame) CollectArrowBatchesCtx(ctx context.Context, batchSize int) (<-chan ArrowBatch, error) {
+	if batchSize <= 0 {
+		return nil, errors.New("CollectArrowBatches() requires batchSize > 0")
+	}
+
+	result, err := df.Collect()
+	if err != nil {
+		return nil, err
+	}
+	// The producer goroutine owns this reference, never the caller's DataFrame
+	owned, err := result.shareHandle()
+	if err != nil {
+		return nil, err
+	}
+
+	stream := (*C.struct_ArrowArrayStream)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowArrayStream{}))))
+	if rc := C.dataframe_to_arrow_stream(owned.handle.handle, C.size_t(batchSize), stream); rc != 0 {
+		C.free(unsafe.Pointer(stream))
+		owned.Release()
+		return nil, errors.New("failed to export dataframe as Arrow stream")
+	}
+
+	batches := make(chan ArrowBatch)
+	go func() {
+		defer close(batches)
+		defer owned.Release()
+		defer C.free(unsafe.Pointer(stream))
+		defer C.firn_stream_release(stream)
+
+		for ctx.Err() == nil {
+			batch, ok := nextArrowBatch(stream)
+			if !ok {
+				return
+			}
+			select {
+			case batches <- batch:
+			case <-ctx.Done():
+				batch.Release() // Nobody is reading any more
+				return
+			}
+			if batch.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return batches, nil
+}
+
+// nextArrowBatch pulls the next batch from the stream; ok is false at end of stream
+func nextArrowBatch(stream *C.struct_ArrowArrayStream) (batch ArrowBatch, ok bool) {
+	array := (*C.struct_ArrowArray)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowArray{}))))
+	if rc := C.firn_stream_get_next(stream, array); rc != 0 {
+		C.free(unsafe.Pointer(array))
+		return ArrowBatch{Err: arrowStreamError(stream, rc)}, true
+	}
+	if array.release == nil {
+		// A released array marks the end of the stream
+		C.free(unsafe.Pointer(array))
+		return ArrowBatch{}, false
+	}
+
+	schema := (*C.struct_ArrowSchema)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowSchema{}))))
+	if rc := C.firn_stream_get_schema(stream, schema); rc != 0 {
+		C.free(unsafe.Pointer(schema))
+		(&ArrowBatch{array: array}).Release()
+		return ArrowBatch{Err: arrowStreamError(stream, rc)}, true
+	}
+
+	return ArrowBatch{schema: schema, array: array}, true
+}
+
+// arrowStreamError converts a stream error code into a Go error with the stream's message
+func arrowStreamError(stream *C.struct_ArrowArrayStream, rc C.int) error {
+	if msg := C.firn_stream_last_error(stream); msg != nil {
+		return fmt.Errorf("arrow stream error %d: %s", int(rc), C.GoString(msg))
+	}
+	return fmt.Errorf("arrow stream error %d", int(rc))
+}
@@ -0,0 +1,134 @@
+package polars
+
+import (
+	"context"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestArrowExport demonstrates exporting results through the Arrow C interfaces
+func TestArrowExport(t *testing.T) {
+	t.Run("CollectArrowBatches", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		defer df.Release()
+		batches, err := df.CollectArrowBatches(3)
+		require.NoError(t, err)
+
+		var sizes []int
+		totalRows := 0
+		for batch := range batches {
+			require.NoError(t, batch.Err)
+			require.NotNil(t, batch.SchemaPtr())
+			require.NotNil(t, batch.ArrayPtr())
+			sizes = append(sizes, batch.NumRows())
+			totalRows += batch.NumRows()
+			batch.Release()
+		}
+
+		// 7 rows split into batches of 3: [3, 3, 1]
+		require.Equal(t, []int{3, 3, 1}, sizes)
+		require.Equal(t, 7, totalRows)
+	})
+
+	t.Run("StopEarly", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		df := ReadCSV("../testdata/sample.csv")
+		defer df.Release()
+		batches, err := df.CollectArrowBatchesCtx(ctx, 1)
+		require.NoError(t, err)
+
+		first := <-batches
+		require.NoError(t, first.Err)
+		require.Equal(t, 1, first.NumRows())
+		first.Release()
+
+		// Cancelling stops the producer; at most one in-flight batch is still delivered
+		cancel()
+		remaining := 0
+		for batch := range batches {
+			remaining++
+			batch.Release()
+		}
+		require.LessOrEqual(t, remaining, 1)
+
+		// The stream released only its own reference; the caller's frame is still valid
+		require.Equal(t, 1, handleRefCount(df.handle.handle))
+		height, err := df.Height()
+		require.NoError(t, err)
+		require.Equal(t, 7, height)
+	})
+
+	t.Run("ReleaseWhileStreaming", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv").Select("age")
+		batches, err := df.CollectArrowBatches(1)
+		require.NoError(t, err)
+
+		first := <-batches
+		require.NoError(t, first.Err)
+		first.Release()
+
+		// Releasing the caller's frame mid-stream leaves the stream's reference intact
+		df.Release()
+		rows := 1
+		for batch := range batches {
+			require.NoError(t, batch.Err)
+			rows += batch.NumRows()
+			batch.Release()
+		}
+		require.Equal(t, 7, rows)
+	})
+
+	t.Run("ReleaseTwice", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Limit(2).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		batch, err := result.ToArrowRecord()
+		require.NoError(t, err)
+		batch.Release()
+		require.Nil(t, batch.SchemaPtr())
+		require.Nil(t, batch.ArrayPtr())
+		batch.Release() // No-op
+		require.Equal(t, 0, batch.NumRows())
+	})
+
+	t.Run("ToArrowRecord", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Limit(3).Collect()
+		require.NoError(t, err)
+
+		batch, err := result.ToArrowRecord()
+		require.NoError(t, err)
+		defer batch.Release()
+
+		// The batch owns its buffers, so it outlives the DataFrame
+		result.Release()
+		require.Equal(t, 3, batch.NumRows())
+
+		fields := unsafe.Slice(batch.schema.children, int(batch.schema.n_children))
+		children := unsafe.Slice(batch.array.children, int(batch.array.n_children))
+		require.Len(t, fields, 2)
+
+		names, err := arrowColumn(fields[0], children[0])
+		require.NoError(t, err)
+		require.Equal(t, "name", names.Name)
+		require.Equal(t, []string{"Alice", "Bob", "Charlie"}, names.Values)
+
+		ages, err := arrowColumn(fields[1], children[1])
+		require.NoError(t, err)
+		require.Equal(t, []int64{25, 30, 35}, ages.Values)
+
+		lazy := ReadCSV("../testdata/sample.csv")
+		defer lazy.Release()
+		_, err = lazy.ToArrowRecord()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "must be executed")
+	})
+
+	t.Run("InvalidBatchSize", func(t *testing.T) {
+		_, err := ReadCSV("../testdata/sample.csv").CollectArrowBatches(0)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "batchSize > 0")
+	})
+}
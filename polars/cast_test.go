@@ -48,6 +48,7 @@ func TestCastOperations(t *testing.T) {
 			{"String", String, 0x0002_0001},
 			{"Boolean", Boolean, 0x0004_0001},
 			{"Date", Date, 0x0003_0001},
+			{"Struct", Struct, 0x0006_0001},
 		}
 		
 		for _, tc := range testCases {
@@ -0,0 +1,268 @@
+package polars
+
+/*
+#include "firn.h"
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// ColumnarResult holds a collected DataFrame as one typed Go slice per column
+// All data is copied out of Rust memory, so the result needs no Release().
+type ColumnarResult struct {
+	NumRows int
+	Columns []ColumnData
+}
+
+// ColumnData is a single column of a ColumnarResult
+// Values holds a typed slice: []int8..[]int64, []uint8..[]uint64, []float32, []float64,
+// []bool or []string. Valid is nil when the column has no nulls; otherwise Valid[i]
+// is false where row i is null (the slot in Values is then unspecified).
+type ColumnData struct {
+	Name   string
+	Values any
+	Valid  []bool
+}
+
+// IsNull reports whether row i of the column is null
+func (c ColumnData) IsNull(i int) bool {
+	return c.Valid != nil && !c.Valid[i]
+}
+
+// Column returns the column with the given name
+func (r *ColumnarResult) Column(name string) (ColumnData, error) {
+	for _, column := range r.Columns {
+		if column.Name == name {
+			return column, nil
+		}
+	}
+	return ColumnData{}, fmt.Errorf("column %q not found", name)
+}
+
+// ColumnValues returns the named column's values as a []T
+// Example: salaries, err := ColumnValues[int64](result, "salary")
+func ColumnValues[T any](r *ColumnarResult, name string) ([]T, error) {
+	column, err := r.Column(name)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := column.Values.([]T)
+	if !ok {
+		var zero T
+		return nil, fmt.Errorf("column %q holds %T, not []%T", name, column.Values, zero)
+	}
+	return values, nil
+}
+
+// CollectColumnar collects the DataFrame and copies every column into a typed Go slice
+// plus null mask, exported through the Arrow C Data Interface in a single FFI call
+// The DataFrame keeps its collected handle and must still be released by the caller.
+// Example: result, err := df.CollectColumnar(); ages, err := ColumnValues[int64](result, "age")
+func (df *DataFrame) CollectColumnar() (*ColumnarResult, error) {
+	result, err := df.Collect()
+	if err != nil {
+		return nil, err
+	}
+
+	schema := (*C.struct_ArrowSchema)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowSchema{}))))
+	array := (*C.struct_ArrowArray)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowArray{}))))
+	defer (&ArrowBatch{schema: schema, array: array}).Release()
+
+	if rc := C.dataframe_to_arrow_array(result.handle.handle, schema, array); rc != 0 {
+		return nil, errors.New("failed to export dataframe as Arrow array")
+	}
+
+	fields := unsafe.Slice(schema.children, int(schema.n_children))
+	children := unsafe.Slice(array.children, int(array.n_children))
+	columnar := &ColumnarResult{
+		NumRows: int(array.length),
+		Columns: make([]ColumnData, len(fields)),
+	}
+	for i := range fields {
+		column, err := arrowColumn(fields[i], children[i])
+		if err != nil {
+			return nil, err
+		}
+		columnar.Columns[i] = column
+	}
+	return columnar, nil
+}
+
+// ColumnInt64 copies an executed DataFrame's i64 column into Go
+// valid is nil when the column has no nulls; otherwise valid[i] is false where row i is null.
+// Other integer widths must be cast first, e.g. Col("age").Cast(Int64).
+// Example: salaries, valid, err := result.ColumnInt64("salary")
+func (df *DataFrame) ColumnInt64(name string) (values []int64, valid []bool, err error) {
+	return columnOf[int64](df, name, "ColumnInt64")
+}
+
+// ColumnFloat64 copies an executed DataFrame's f64 column into Go with its null mask
+func (df *DataFrame) ColumnFloat64(name string) (values []float64, valid []bool, err error) {
+	return columnOf[float64](df, name, "ColumnFloat64")
+}
+
+// ColumnString copies an executed DataFrame's str column into Go with its null mask
+func (df *DataFrame) ColumnString(name string) (values []string, valid []bool, err error) {
+	return columnOf[string](df, name, "ColumnString")
+}
+
+// ColumnBool copies an executed DataFrame's bool column into Go with its null mask
+func (df *DataFrame) ColumnBool(name string) (values []bool, valid []bool, err error) {
+	return columnOf[bool](df, name, "ColumnBool")
+}
+
+// columnOf exports a single column and copies it as []T
+func columnOf[T any](df *DataFrame, name, method string) ([]T, []bool, error) {
+	if df.handle.handle == 0 {
+		return nil, nil, fmt.Errorf("DataFrame must be executed before calling %s()", method)
+	}
+
+	column, err := exportColumn(df, name, method)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, ok := column.Values.([]T)
+	if !ok {
+		var zero T
+		return nil, nil, fmt.Errorf("%s: column %q holds %T, not []%T", method, name, column.Values, zero)
+	}
+	return values, column.Valid, nil
+}
+
+// exportColumn copies one column of an executed DataFrame through the Arrow C Data Interface
+func exportColumn(df *DataFrame, name, method string) (ColumnData, error) {
+	schema := (*C.struct_ArrowSchema)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowSchema{}))))
+	array := (*C.struct_ArrowArray)(C.calloc(1, C.size_t(unsafe.Sizeof(C.struct_ArrowArray{}))))
+	defer (&ArrowBatch{schema: schema, array: array}).Release()
+
+	switch rc := C.dataframe_column_to_arrow(df.handle.handle, makeRawStr(name), schema, array); rc {
+	case 0:
+	case 2:
+		return ColumnData{}, fmt.Errorf("%s: column %q not found", method, name)
+	default:
+		return ColumnData{}, fmt.Errorf("%s: failed to export column %q as Arrow array", method, name)
+	}
+	return arrowColumn(schema, array)
+}
+
+// ToRecords converts an executed DataFrame into one map per row, keyed by column name
+// Values have the Go type of their column (int64, float64, string, bool, ...) and nulls are nil.
+// Every cell is boxed in an interface, so this costs far more memory than the frame itself:
+// keep it for small results (e.g. feeding a JSON API) and use ColumnInt64/ColumnFloat64/...
+// or CollectColumnar for large ones.
+// Example: rows, err := result.ToRecords(); json.NewEncoder(w).Encode(rows)
+func (df *DataFrame) ToRecords() ([]map[string]any, error) {
+	names, err := df.Columns()
+	if err != nil {
+		return nil, err
+	}
+	height, err := df.Height()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]map[string]any, height)
+	for i := range records {
+		records[i] = make(map[string]any, len(names))
+	}
+	for _, name := range names {
+		column, err := exportColumn(df, name, "ToRecords")
+		if err != nil {
+			return nil, err
+		}
+		values := reflect.ValueOf(column.Values)
+		for i, record := range records {
+			if column.IsNull(i) {
+				record[name] = nil
+			} else {
+				record[name] = values.Index(i).Interface()
+			}
+		}
+	}
+	return records, nil
+}
+
+// arrowColumn copies one Arrow child array into a ColumnData
+func arrowColumn(field *C.struct_ArrowSchema, array *C.struct_ArrowArray) (ColumnData, error) {
+	column := ColumnData{Name: C.GoString(field.name)}
+	length := int(array.length)
+	offset := int(array.offset)
+	buffers := unsafe.Slice(array.buffers, int(array.n_buffers))
+
+	if array.null_count != 0 && buffers[0] != nil {
+		column.Valid = arrowBitmap(buffers[0], offset, length)
+	}
+
+	switch format := C.GoString(field.format); format {
+	case "c":
+		column.Values = arrowPrimitive[int8](buffers[1], offset, length)
+	case "s":
+		column.Values = arrowPrimitive[int16](buffers[1], offset, length)
+	case "i":
+		column.Values = arrowPrimitive[int32](buffers[1], offset, length)
+	case "l":
+		column.Values = arrowPrimitive[int64](buffers[1], offset, length)
+	case "C":
+		column.Values = arrowPrimitive[uint8](buffers[1], offset, length)
+	case "S":
+		column.Values = arrowPrimitive[uint16](buffers[1], offset, length)
+	case "I":
+		column.Values = arrowPrimitive[uint32](buffers[1], offset, length)
+	case "L":
+		column.Values = arrowPrimitive[uint64](buffers[1], offset, length)
+	case "f":
+		column.Values = arrowPrimitive[float32](buffers[1], offset, length)
+	case "g":
+		column.Values = arrowPrimitive[float64](buffers[1], offset, length)
+	case "b":
+		column.Values = arrowBitmap(buffers[1], offset, length)
+	case "U":
+		column.Values = arrowLargeStrings(buffers[1], buffers[2], offset, length)
+	default:
+		return ColumnData{}, fmt.Errorf("CollectColumnar: column %q has unsupported Arrow format %q", column.Name, format)
+	}
+	return column, nil
+}
+
+// arrowPrimitive copies a fixed-width Arrow values buffer into a Go slice
+func arrowPrimitive[T any](buffer unsafe.Pointer, offset, length int) []T {
+	values := make([]T, length)
+	if length > 0 {
+		copy(values, unsafe.Slice((*T)(buffer), offset+length)[offset:])
+	}
+	return values
+}
+
+// arrowBitmap expands an Arrow bit-packed buffer into one bool per row
+func arrowBitmap(buffer unsafe.Pointer, offset, length int) []bool {
+	values := make([]bool, length)
+	if length == 0 {
+		return values
+	}
+	bits := unsafe.Slice((*byte)(buffer), (offset+length+7)/8)
+	for i := range values {
+		bit := offset + i
+		values[i] = bits[bit/8]&(1<<(bit%8)) != 0
+	}
+	return values
+}
+
+// arrowLargeStrings copies an Arrow LargeUtf8 array (int64 offsets) into Go strings
+func arrowLargeStrings(offsetsBuffer, dataBuffer unsafe.Pointer, offset, length int) []string {
+	values := make([]string, length)
+	if length == 0 {
+		return values
+	}
+	offsets := unsafe.Slice((*int64)(offsetsBuffer), offset+length+1)[offset:]
+	for i := range values {
+		start, end := offsets[i], offsets[i+1]
+		if end > start {
+			values[i] = C.GoStringN((*C.char)(unsafe.Add(dataBuffer, start)), C.int(end-start))
+		}
+	}
+	return values
+}
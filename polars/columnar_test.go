@@ -0,0 +1,162 @@
+package polars
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestColumnarCollect demonstrates collecting results as typed Go slices per column
+func TestColumnarCollect(t *testing.T) {
+	t.Run("TypedColumns", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		defer df.Release()
+
+		result, err := df.CollectColumnar()
+		require.NoError(t, err)
+		require.Equal(t, 7, result.NumRows)
+
+		salaries, err := ColumnValues[int64](result, "salary")
+		require.NoError(t, err)
+		require.Equal(t, []int64{50000, 60000, 70000, 55000, 65000, 58000, 52000}, salaries)
+
+		names, err := ColumnValues[string](result, "name")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Alice", "Bob", "Charlie", "Diana", "Eve", "Frank", "Grace"}, names)
+
+		salary, err := result.Column("salary")
+		require.NoError(t, err)
+		require.Nil(t, salary.Valid) // No nulls, no mask
+
+		_, err = ColumnValues[float64](result, "salary")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "holds []int64")
+	})
+
+	t.Run("NullMask", func(t *testing.T) {
+		collected, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Limit(2).Collect()
+		require.NoError(t, err)
+		defer collected.Release()
+
+		result, err := collected.addNullRowForTesting().CollectColumnar()
+		require.NoError(t, err)
+		require.Equal(t, 3, result.NumRows)
+
+		age, err := result.Column("age")
+		require.NoError(t, err)
+		require.Equal(t, []bool{true, true, false}, age.Valid)
+		require.True(t, age.IsNull(2))
+		require.Equal(t, []int64{25, 30}, age.Values.([]int64)[:2])
+	})
+
+	t.Run("MissingColumn", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		defer df.Release()
+
+		result, err := df.CollectColumnar()
+		require.NoError(t, err)
+
+		_, err = result.Column("bonus")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `column "bonus" not found`)
+	})
+}
+
+// TestColumnExtractors demonstrates reading single typed columns back into Go
+func TestColumnExtractors(t *testing.T) {
+	t.Run("TypedValues", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			Select(
+				"name",
+				"salary",
+				Col("salary").Div(Lit(1000.0)).Alias("salary_k"),
+				Col("age").Gt(Lit(30)).Alias("senior"),
+			).
+			Limit(3).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		names, valid, err := result.ColumnString("name")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Alice", "Bob", "Charlie"}, names)
+		require.Nil(t, valid) // No nulls, no mask
+
+		salaries, _, err := result.ColumnInt64("salary")
+		require.NoError(t, err)
+		require.Equal(t, []int64{50000, 60000, 70000}, salaries)
+
+		salaryK, _, err := result.ColumnFloat64("salary_k")
+		require.NoError(t, err)
+		require.Equal(t, []float64{50, 60, 70}, salaryK)
+
+		senior, _, err := result.ColumnBool("senior")
+		require.NoError(t, err)
+		require.Equal(t, []bool{false, false, true}, senior)
+	})
+
+	t.Run("NullMask", func(t *testing.T) {
+		collected, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Limit(2).Collect()
+		require.NoError(t, err)
+		defer collected.Release()
+
+		withNull, err := collected.addNullRowForTesting().Collect()
+		require.NoError(t, err)
+		ages, valid, err := withNull.ColumnInt64("age")
+		require.NoError(t, err)
+		require.Len(t, ages, 3)
+		require.Equal(t, []int64{25, 30}, ages[:2])
+		require.Equal(t, []bool{true, true, false}, valid)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		_, _, err = result.ColumnFloat64("salary")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `ColumnFloat64: column "salary" holds []int64, not []float64`)
+
+		_, _, err = result.ColumnString("bonus")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `column "bonus" not found`)
+
+		lazy := ReadCSV("../testdata/sample.csv")
+		defer lazy.Release()
+		_, _, err = lazy.ColumnBool("name")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "must be executed")
+	})
+}
+
+// TestToRecords demonstrates exporting rows as Go maps
+func TestToRecords(t *testing.T) {
+	t.Run("RowsWithNulls", func(t *testing.T) {
+		collected, err := ReadCSV("../testdata/sample.csv").
+			Select("name", "age", Col("age").Gt(Lit(26)).Alias("over_26")).
+			Limit(2).
+			Collect()
+		require.NoError(t, err)
+		defer collected.Release()
+
+		withNull, err := collected.addNullRowForTesting().Collect()
+		require.NoError(t, err)
+
+		records, err := withNull.ToRecords()
+		require.NoError(t, err)
+		require.Equal(t, []map[string]any{
+			{"name": "Alice", "age": int64(25), "over_26": false},
+			{"name": "Bob", "age": int64(30), "over_26": true},
+			{"name": nil, "age": nil, "over_26": nil},
+		}, records)
+	})
+
+	t.Run("RequiresExecutedFrame", func(t *testing.T) {
+		lazy := ReadCSV("../testdata/sample.csv")
+		defer lazy.Release()
+		_, err := lazy.ToRecords()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "must be executed")
+	})
+}
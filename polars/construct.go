@@ -0,0 +1,261 @@
+package polars
+
+/*
+#include "firn.h"
+*/
+import "C"
+import (
+	"fmt"
+	"sort"
+	"unsafe"
+)
+
+// In-memory column dtypes, matching GO_COLUMN_* in firn.h
+const (
+	goColumnInt64   = C.GO_COLUMN_INT64
+	goColumnFloat64 = C.GO_COLUMN_FLOAT64
+	goColumnString  = C.GO_COLUMN_STRING
+	goColumnBool    = C.GO_COLUMN_BOOL
+)
+
+// goColumn is a column of Go values converted to a single dtype, ready to hand to Rust
+type goColumn struct {
+	name    string
+	dtype   int
+	ints    []C.int64_t
+	floats  []C.double
+	strings []string
+	bools   []C.bool
+	valid   []C.bool // nil when the column has no nulls
+}
+
+// FromColumns creates a DataFrame from Go slices keyed by column name
+// All columns must have the same length. Each column's dtype is inferred from its non-nil
+// values: Go integers become i64, float32/float64 become f64 (integers mixed with floats are
+// promoted), and string and bool map directly; nil is null. Columns appear in sorted name
+// order, since Go map iteration order is random.
+// Example: FromColumns(map[string][]any{"city": {"Oslo", "Lima"}, "high": {12.5, nil}})
+func FromColumns(cols map[string][]any) (*DataFrame, error) {
+	return fromColumnMap(cols, "FromColumns")
+}
+
+// FromRecords creates a DataFrame from rows of column name to value
+// Columns are the union of all record keys in sorted order; a key missing from a record is
+// null there. Dtypes are inferred per column as in FromColumns.
+// Example: FromRecords([]map[string]any{{"city": "Oslo", "high": 12.5}, {"city": "Lima"}})
+func FromRecords(records []map[string]any) (*DataFrame, error) {
+	if len(records) == 0 {
+		return nil, fmt.Errorf("FromRecords: at least one record is required")
+	}
+
+	cols := make(map[string][]any)
+	for _, record := range records {
+		for name := range record {
+			if _, ok := cols[name]; !ok {
+				cols[name] = make([]any, len(records))
+			}
+		}
+	}
+	for i, record := range records {
+		for name, value := range record {
+			cols[name][i] = value
+		}
+	}
+
+	return fromColumnMap(cols, "FromRecords")
+}
+
+// fromColumnMap validates and converts named Go columns, ordering them by name
+func fromColumnMap(cols map[string][]any, method string) (*DataFrame, error) {
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("%s: at least one column is required", method)
+	}
+
+	names := make([]string, 0, len(cols))
+	for name := range cols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	length := len(cols[names[0]])
+	columns := make([]goColumn, len(names))
+	for i, name := range names {
+		values := cols[name]
+		if len(values) != length {
+			return nil, fmt.Errorf("%s: column %q has %d values, but column %q has %d",
+				method, name, len(values), names[0], length)
+		}
+		column, err := newGoColumn(name, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", method, err)
+		}
+		columns[i] = column
+	}
+	return fromGoColumns(columns, method+"()"), nil
+}
+
+// newGoColumn infers a column's dtype and converts its values
+func newGoColumn(name string, values []any) (goColumn, error) {
+	column := goColumn{name: name, dtype: -1}
+	for _, value := range values {
+		var dtype int
+		switch value.(type) {
+		case nil:
+			continue
+		case int, int8, int16, int32, int64, uint8, uint16, uint32:
+			dtype = goColumnInt64
+		case float32, float64:
+			dtype = goColumnFloat64
+		case string:
+			dtype = goColumnString
+		case bool:
+			dtype = goColumnBool
+		default:
+			return goColumn{}, fmt.Errorf("column %q: unsupported value type %T", name, value)
+		}
+
+		switch {
+		case column.dtype < 0 || column.dtype == dtype:
+			column.dtype = dtype
+		case column.dtype == goColumnInt64 && dtype == goColumnFloat64,
+			column.dtype == goColumnFloat64 && dtype == goColumnInt64:
+			column.dtype = goColumnFloat64
+		default:
+			return goColumn{}, fmt.Errorf("column %q mixes %s and %T values", name, goColumnTypeName(column.dtype), value)
+		}
+	}
+	if column.dtype < 0 {
+		return goColumn{}, fmt.Errorf("column %q has no non-nil values to infer a dtype from", name)
+	}
+
+	switch column.dtype {
+	case goColumnInt64:
+		column.ints = make([]C.int64_t, len(values))
+	case goColumnFloat64:
+		column.floats = make([]C.double, len(values))
+	case goColumnString:
+		column.strings = make([]string, len(values))
+	case goColumnBool:
+		column.bools = make([]C.bool, len(values))
+	}
+	for i, value := range values {
+		if value == nil {
+			if column.valid == nil {
+				column.valid = make([]C.bool, len(values))
+				for j := range column.valid {
+					column.valid[j] = true
+				}
+			}
+			column.valid[i] = false
+			continue
+		}
+		switch column.dtype {
+		case goColumnInt64:
+			column.ints[i] = C.int64_t(goInt(value))
+		case goColumnFloat64:
+			column.floats[i] = C.double(goFloat(value))
+		case goColumnString:
+			column.strings[i] = value.(string)
+		case goColumnBool:
+			column.bools[i] = C.bool(value.(bool))
+		}
+	}
+	return column, nil
+}
+
+// goInt converts any supported Go integer to int64
+func goInt(value any) int64 {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	}
+	return 0
+}
+
+// goFloat converts a Go float, or an integer promoted in a float column, to float64
+func goFloat(value any) float64 {
+	switch v := value.(type) {
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return float64(goInt(value))
+}
+
+func goColumnTypeName(dtype int) string {
+	switch dtype {
+	case goColumnInt64:
+		return "integer"
+	case goColumnFloat64:
+		return "float"
+	case goColumnString:
+		return "string"
+	default:
+		return "bool"
+	}
+}
+
+// fromGoColumns creates a lazy DataFrame whose source is the converted Go columns
+func fromGoColumns(columns []goColumn, source string) *DataFrame {
+	op := Operation{
+		opcode: OpFromColumns,
+		source: source,
+		args: func() unsafe.Pointer {
+			// Closure captures columns, keeping the Go buffers and string data alive
+			cColumns := make([]C.GoColumn, len(columns))
+			for i, column := range columns {
+				cColumn := C.GoColumn{
+					name:  makeRawStr(column.name),
+					dtype: C.uint32_t(column.dtype),
+				}
+				switch column.dtype {
+				case goColumnInt64:
+					cColumn.len = C.size_t(len(column.ints))
+					if len(column.ints) > 0 {
+						cColumn.ints = &column.ints[0]
+					}
+				case goColumnFloat64:
+					cColumn.len = C.size_t(len(column.floats))
+					if len(column.floats) > 0 {
+						cColumn.floats = &column.floats[0]
+					}
+				case goColumnString:
+					cColumn.strings, cColumn.len = makeRawStrArray(column.strings)
+				case goColumnBool:
+					cColumn.len = C.size_t(len(column.bools))
+					if len(column.bools) > 0 {
+						cColumn.bools = &column.bools[0]
+					}
+				}
+				if column.valid != nil {
+					cColumn.valid = &column.valid[0]
+				}
+				cColumns[i] = cColumn
+			}
+			return unsafe.Pointer(&C.FromColumnsArgs{
+				columns:      &cColumns[0],
+				column_count: C.size_t(len(cColumns)),
+			})
+		},
+	}
+
+	return &DataFrame{
+		handle:     C.PolarsHandle{handle: C.uintptr_t(0), context_type: C.uint32_t(0)}, // Lazy - no handle yet
+		operations: []Operation{op},
+	}
+}
@@ -0,0 +1,104 @@
+package polars
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFromGoValues demonstrates building DataFrames from Go slices and maps
+func TestFromGoValues(t *testing.T) {
+	t.Run("FromColumns", func(t *testing.T) {
+		df, err := FromColumns(map[string][]any{
+			"visits": {3, int64(5), nil},
+			"city":   {"Oslo", "Lima", "Pune"},
+			"high":   {12.5, nil, 31}, // Integers mixed with floats are promoted
+			"rain":   {true, false, nil},
+		})
+		require.NoError(t, err)
+		defer df.Release()
+
+		result, err := df.Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: columns in sorted name order with inferred dtypes
+		expected := `shape: (3, 4)
+┌──────┬──────┬───────┬────────┐
+│ city ┆ high ┆ rain  ┆ visits │
+│ ---  ┆ ---  ┆ ---   ┆ ---    │
+│ str  ┆ f64  ┆ bool  ┆ i64    │
+╞══════╪══════╪═══════╪════════╡
+│ Oslo ┆ 12.5 ┆ true  ┆ 3      │
+│ Lima ┆ null ┆ false ┆ 5      │
+│ Pune ┆ 31.0 ┆ null  ┆ null   │
+└──────┴──────┴───────┴────────┘`
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("FromRecords", func(t *testing.T) {
+		df, err := FromRecords([]map[string]any{
+			{"city": "Oslo", "high": 12.5},
+			{"city": "Lima"}, // Missing keys are null
+		})
+		require.NoError(t, err)
+		defer df.Release()
+
+		result, err := df.Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (2, 2)
+┌──────┬──────┐
+│ city ┆ high │
+│ ---  ┆ ---  │
+│ str  ┆ f64  │
+╞══════╪══════╡
+│ Oslo ┆ 12.5 │
+│ Lima ┆ null │
+└──────┴──────┘`
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ChainsLikeAnyFrame", func(t *testing.T) {
+		df, err := FromColumns(map[string][]any{
+			"name":   {"Alice", "Bob", "Charlie"},
+			"salary": {50000, 60000, 70000},
+		})
+		require.NoError(t, err)
+
+		result, err := df.Filter(Col("salary").Gt(Lit(55000))).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		names, _, err := result.ColumnString("name")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Bob", "Charlie"}, names)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := FromColumns(map[string][]any{"a": {1, 2}, "b": {1}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `FromColumns: column "b" has 1 values, but column "a" has 2`)
+
+		_, err = FromColumns(map[string][]any{"a": {1, "x"}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `column "a" mixes integer and string values`)
+
+		_, err = FromColumns(map[string][]any{"a": {nil, nil}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `column "a" has no non-nil values`)
+
+		_, err = FromColumns(map[string][]any{"a": {[]int{1}}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `column "a": unsupported value type []int`)
+
+		_, err = FromRecords([]map[string]any{{"a": true}, {"a": 1.5}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `FromRecords: column "a" mixes bool and float64 values`)
+
+		_, err = FromRecords(nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "at least one record is required")
+	})
+}
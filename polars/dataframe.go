@@ -5,8 +5,15 @@ package polars
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
 	"unsafe"
 )
 
@@ -21,11 +28,64 @@ func makeRawStr(s string) C.RawStr {
 	}
 }
 
+// Helper function to create a RawStr array from Go strings (zero-copy per element)
+// Returns a nil pointer and zero count for an empty slice
+func makeRawStrArray(strs []string) (*C.RawStr, C.size_t) {
+	if len(strs) == 0 {
+		return nil, 0
+	}
+	rawStrs := make([]C.RawStr, len(strs))
+	for i, s := range strs {
+		rawStrs[i] = makeRawStr(s)
+	}
+	return &rawStrs[0], C.size_t(len(strs))
+}
+
+// Helper function to create a bit-packed dtype array for the Rust side
+// Returns a nil pointer and zero count for an empty slice
+func makeDtypeArray(dtypes []DataType) (*C.uint32_t, C.size_t) {
+	if len(dtypes) == 0 {
+		return nil, 0
+	}
+	encoded := make([]C.uint32_t, len(dtypes))
+	for i, dtype := range dtypes {
+		encoded[i] = C.uint32_t(dtype)
+	}
+	return &encoded[0], C.size_t(len(dtypes))
+}
+
 // Operation represents a single DataFrame operation with opcode and args
 type Operation struct {
 	opcode uint32                // OpCode for the operation
 	args   func() unsafe.Pointer // Lazy args allocation via closure (keeps references alive naturally)
 	err    error                 // Error associated with this operation (if any)
+	source string                // Builder call that added the operation, e.g. "Filter()" (for error messages)
+	call   int                   // Index of that builder call within the pending chain (see Error.UserFrame)
+
+	// retained are handles the operation co-owns until Rust runs it (a join's right side,
+	// including the right sides of joins in its captured chain); see captureJoinRight
+	retained []C.uintptr_t
+}
+
+// releaseRetained drops the handles co-owned by operations that will never reach Rust
+func releaseRetained(ops []Operation) {
+	for _, op := range ops {
+		for _, handle := range op.retained {
+			C.release_dataframe(handle)
+		}
+	}
+}
+
+// cOperation converts the operation to its C form, allocating its args (lazy allocation)
+func (op Operation) cOperation() C.Operation {
+	var argsPtr unsafe.Pointer
+	if op.args != nil {
+		argsPtr = op.args() // Direct unsafe.Pointer, no type switch needed!
+	}
+	return C.Operation{
+		opcode: C.uint32_t(op.opcode),
+		args:   C.uintptr_t(uintptr(argsPtr)),
+	}
 }
 
 // Helper functions for creating error operations
@@ -50,16 +110,47 @@ func errOpf(format string, args ...interface{}) Operation {
 
 // appendErrOp appends an error operation to a DataFrame and returns it
 func (df *DataFrame) appendErrOp(message string) *DataFrame {
-	df.operations = append(df.operations, errOp(message))
-	return df
+	return df.appendOps(callerSource(), errOp(message))
 }
 
 // appendErrOpf appends a formatted error operation to a DataFrame and returns it
 func (df *DataFrame) appendErrOpf(format string, args ...interface{}) *DataFrame {
-	df.operations = append(df.operations, errOpf(format, args...))
+	return df.appendOps(callerSource(), errOpf(format, args...))
+}
+
+// appendOps appends operations labelled with the builder call that added them
+// Each call to appendOps starts a new builder call, except while expression ops are
+// pending: those are always consumed by a DataFrame op from the same builder call.
+func (df *DataFrame) appendOps(source string, ops ...Operation) *DataFrame {
+	for _, op := range ops {
+		if n := len(df.operations); n > 0 {
+			last := df.operations[n-1]
+			op.call = last.call
+			if !isExprOpcode(last.opcode) {
+				op.call++
+			}
+		}
+		op.source = source
+		df.operations = append(df.operations, op)
+	}
 	return df
 }
 
+// isExprOpcode reports whether an opcode pushes onto the expression stack
+func isExprOpcode(opcode uint32) bool {
+	return opcode >= OpExprColumn && opcode < OpError
+}
+
+// callerSource names the builder method that called appendErrOp/appendErrOpf, e.g. "Filter()"
+func callerSource() string {
+	pc, _, _, ok := runtime.Caller(2)
+	if !ok {
+		return ""
+	}
+	name := runtime.FuncForPC(pc).Name()
+	return name[strings.LastIndex(name, ".")+1:] + "()"
+}
+
 // DataFrame represents a Polars DataFrame with lazy operations
 type DataFrame struct {
 	handle     C.PolarsHandle // Handle with context type information
@@ -71,9 +162,18 @@ type Error struct {
 	Code    int
 	Message string
 	Frame   int
+	Source  string // Builder call that added the failing operation, e.g. "Filter()" (empty if unknown)
+	// UserFrame is the index of the failing builder call in the chain, counting the
+	// constructor (e.g. ReadCSV) as 0. Unlike Frame, it ignores expression ops and the
+	// other internal operations a single builder call expands into.
+	UserFrame int
 }
 
 func (e *Error) Error() string {
+	if e.Source != "" {
+		return fmt.Sprintf("polars error %d at %s [call %d, operation %d]: %s",
+			e.Code, e.Source, e.UserFrame, e.Frame, e.Message)
+	}
 	if e.Frame > 0 {
 		return fmt.Sprintf("polars error %d at operation %d: %s", e.Code, e.Frame, e.Message)
 	}
@@ -84,6 +184,7 @@ func (e *Error) Error() string {
 func NewDataFrame() *DataFrame {
 	op := Operation{
 		opcode: OpNewEmpty,
+		source: "NewDataFrame()",
 		args:   func() unsafe.Pointer { return unsafe.Pointer(&C.CountArgs{}) }, // Lazy allocation
 	}
 	
@@ -102,13 +203,142 @@ func ReadCSV(path string) *DataFrame {
 
 // ReadCSVWithOptions creates a DataFrame from a CSV file with configurable options
 func ReadCSVWithOptions(path string, hasHeader bool, withGlob bool) *DataFrame {
+	return ReadCSVWithConfig(path, CSVReadConfig{
+		HasHeader: hasHeader,
+		WithGlob:  withGlob,
+	})
+}
+
+// CSVReadConfig configures CSV reading options
+type CSVReadConfig struct {
+	HasHeader    bool // Whether CSV has header row
+	WithGlob     bool // Whether to expand glob patterns
+	Separator    byte // Field separator, e.g. '\t' or ';' (0 = ',', or ';' when DecimalComma is set)
+	QuoteChar    byte // Quote character for fields containing separators (0 = '"')
+	Comment      byte // Lines starting with this byte are skipped, e.g. '#' (0 = none)
+	// DecimalComma parses European numbers such as "1.234,56" as 1234.56. Text columns whose
+	// values all have this form are converted to Float64; every row is checked, so the file
+	// is read once more before the query runs.
+	DecimalComma bool
+
+	// DecimalCommaColumns forces the listed columns through the same conversion without
+	// detection, and implies DecimalComma. A value that is not such a number fails the query.
+	DecimalCommaColumns []string
+
+	// NullValues are field values read as null in every column, e.g. "NA", "NULL" or "-"
+	// Numeric columns holding these tokens are still inferred as numbers.
+	NullValues []string
+
+	SkipRows            int // Lines skipped before the header, e.g. an export preamble
+	SkipRowsAfterHeader int // Data rows skipped after the header
+	NRows               int // Maximum rows to read (0 = all); with a glob, the limit is across all files
+
+	// InferSchemaLength is the number of rows used to infer column dtypes (0 = 100 rows,
+	// negative = every row). Raising it fixes columns whose first rows look like integers but
+	// later hold floats or text, at the cost of reading further before the query starts.
+	InferSchemaLength int
+
+	// SchemaOverrides forces the dtype of the named columns instead of inferring it,
+	// e.g. {"zip": String} keeps leading zeros. Columns not listed keep inferred dtypes.
+	SchemaOverrides map[string]DataType
+}
+
+// csvArgs converts the config for the Rust CSV readers
+// It must be called inside an args closure, which keeps the referenced strings alive.
+func (c CSVReadConfig) csvArgs() C.CsvConfigArgs {
+	decimalComma := c.DecimalComma || len(c.DecimalCommaColumns) > 0
+	separator := c.Separator
+	if separator == 0 && decimalComma {
+		// Comma-decimal files cannot also be comma-separated
+		separator = ';'
+	}
+	nullValues, nullValueCount := makeRawStrArray(c.NullValues)
+	decimalCommaColumns, decimalCommaColumnCount := makeRawStrArray(c.DecimalCommaColumns)
+
+	// Thousands-separated columns are read as text and converted on the Rust side
+	overrides := make(map[string]DataType, len(c.SchemaOverrides)+len(c.DecimalCommaColumns))
+	for name, dtype := range c.SchemaOverrides {
+		overrides[name] = dtype
+	}
+	for _, name := range c.DecimalCommaColumns {
+		overrides[name] = String
+	}
+
+	overrideNames := make([]string, 0, len(overrides))
+	for name := range overrides {
+		overrideNames = append(overrideNames, name)
+	}
+	sort.Strings(overrideNames)
+	overrideDtypes := make([]DataType, len(overrideNames))
+	for i, name := range overrideNames {
+		overrideDtypes[i] = overrides[name]
+	}
+	overrideNamesPtr, overrideCount := makeRawStrArray(overrideNames)
+	overrideDtypesPtr, _ := makeDtypeArray(overrideDtypes)
+
+	inferSchemaLength := c.InferSchemaLength
+	switch {
+	case inferSchemaLength == 0:
+		inferSchemaLength = 100
+	case inferSchemaLength < 0:
+		inferSchemaLength = 0 // Rust treats 0 as "all rows"
+	}
+
+	return C.CsvConfigArgs{
+		has_header:             C.bool(c.HasHeader),
+		separator:              C.uchar(separator),
+		quote_char:             C.uchar(c.QuoteChar),
+		comment:                C.uchar(c.Comment),
+		null_values:            nullValues,
+		null_value_count:       nullValueCount,
+		skip_rows:              C.size_t(c.SkipRows),
+		skip_rows_after_header: C.size_t(c.SkipRowsAfterHeader),
+		n_rows:                 C.size_t(c.NRows),
+		infer_schema_length:    C.size_t(inferSchemaLength),
+		override_names:         overrideNamesPtr,
+		override_dtypes:        overrideDtypesPtr,
+		override_count:         overrideCount,
+		decimal_comma:          C.bool(decimalComma),
+
+		decimal_comma_columns:      decimalCommaColumns,
+		decimal_comma_column_count: decimalCommaColumnCount,
+	}
+}
+
+// validate rejects negative row counts and decimal-comma columns with a dtype override
+func (c CSVReadConfig) validate() error {
+	for _, name := range c.DecimalCommaColumns {
+		if _, ok := c.SchemaOverrides[name]; ok {
+			return fmt.Errorf("column %q cannot be in both DecimalCommaColumns and SchemaOverrides", name)
+		}
+	}
+	switch {
+	case c.SkipRows < 0:
+		return fmt.Errorf("SkipRows must not be negative, got %d", c.SkipRows)
+	case c.SkipRowsAfterHeader < 0:
+		return fmt.Errorf("SkipRowsAfterHeader must not be negative, got %d", c.SkipRowsAfterHeader)
+	case c.NRows < 0:
+		return fmt.Errorf("NRows must not be negative, got %d", c.NRows)
+	}
+	return nil
+}
+
+// ReadCSVWithConfig creates a DataFrame from a CSV file using CSVReadConfig
+// Example: ReadCSVWithConfig("prices.csv", CSVReadConfig{HasHeader: true, DecimalCommaColumns: []string{"price"}})
+// Example: ReadCSVWithConfig("events.tsv", CSVReadConfig{HasHeader: true, Separator: '\t', Comment: '#'})
+func ReadCSVWithConfig(path string, options CSVReadConfig) *DataFrame {
+	if err := options.validate(); err != nil {
+		return (&DataFrame{}).appendErrOpf("ReadCSV: %v", err)
+	}
+
 	op := Operation{
 		opcode: OpReadCsv,
+		source: "ReadCSV()",
 		args: func() unsafe.Pointer {
 			return unsafe.Pointer(&C.ReadCsvArgs{
-				path:       makeRawStr(path), // path captured by closure
-				has_header: C.bool(hasHeader),
-				with_glob:  C.bool(withGlob),
+				path:      makeRawStr(path), // path captured by closure
+				with_glob: C.bool(options.WithGlob),
+				csv:       options.csvArgs(),
 			})
 		},
 	}
@@ -119,6 +349,46 @@ func ReadCSVWithOptions(path string, hasHeader bool, withGlob bool) *DataFrame {
 	}
 }
 
+// ReadCSVBytes creates a DataFrame from CSV data held in memory, without a temp file
+// The buffer is parsed when the DataFrame is collected and must not be modified before then.
+// WithGlob is ignored since there is no path to expand.
+func ReadCSVBytes(data []byte, options CSVReadConfig) *DataFrame {
+	if err := options.validate(); err != nil {
+		return (&DataFrame{}).appendErrOpf("ReadCSVBytes: %v", err)
+	}
+
+	op := Operation{
+		opcode: OpReadCsvBytes,
+		source: "ReadCSVBytes()",
+		args: func() unsafe.Pointer {
+			var dataPtr *C.uint8_t
+			if len(data) > 0 {
+				dataPtr = (*C.uint8_t)(unsafe.Pointer(&data[0])) // data captured by closure
+			}
+			return unsafe.Pointer(&C.ReadCsvBytesArgs{
+				data: dataPtr,
+				len:  C.size_t(len(data)),
+				csv:  options.csvArgs(),
+			})
+		},
+	}
+
+	return &DataFrame{
+		handle:     C.PolarsHandle{handle: C.uintptr_t(0), context_type: C.uint32_t(0)}, // Lazy - no handle yet
+		operations: []Operation{op},
+	}
+}
+
+// ReadCSVFrom creates a DataFrame from CSV data read from r
+// The reader is drained immediately; read errors surface when the DataFrame is collected.
+func ReadCSVFrom(r io.Reader, options CSVReadConfig) *DataFrame {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return (&DataFrame{}).appendErrOpf("ReadCSVFrom: %v", err)
+	}
+	return ReadCSVBytes(data, options)
+}
+
 // ParquetOptions configures Parquet reading options
 type ParquetOptions struct {
 	Columns  []string // Optional column selection (nil = all columns)
@@ -145,6 +415,7 @@ func ReadParquet(path string) *DataFrame {
 func ReadParquetWithOptions(path string, options ParquetOptions) *DataFrame {
 	op := Operation{
 		opcode: OpReadParquet,
+		source: "ReadParquet()",
 		args: func() unsafe.Pointer {
 			var columnsPtr *C.RawStr
 			var columnCount C.size_t
@@ -177,20 +448,250 @@ func ReadParquetWithOptions(path string, options ParquetOptions) *DataFrame {
 	}
 }
 
+// ScanCSV lazily scans a CSV file (or glob) with a header row
+// Nothing is read until Collect; Polars then pushes filters, projections and limits into
+// the scan. ReadCSV builds the same LazyFrame scan - ScanCSV just names the intent.
+// Example: ScanCSV("events_*.csv").Filter(Col("status").Eq(Lit("failed"))).Limit(10)
+func ScanCSV(path string) *DataFrame {
+	return ReadCSV(path)
+}
+
+// ScanParquet lazily scans a Parquet file (or glob)
+// Predicate and projection pushdown happen at Collect, so ScanParquet(path).Filter(...).Limit(10)
+// reads only the columns and row groups it needs. ReadParquet builds the same LazyFrame scan.
+func ScanParquet(path string) *DataFrame {
+	return ReadParquet(path)
+}
+
+// ReadIPC creates a DataFrame from an Arrow IPC (Feather v2) file or glob pattern
+// IPC preserves dtypes exactly, which makes it the fastest lossless round trip between services.
+// Example: ReadIPC("handoff/orders.arrow").Filter(Col("amount").Gt(Lit(100)))
+func ReadIPC(path string) *DataFrame {
+	op := Operation{
+		opcode: OpReadIpc,
+		source: "ReadIPC()",
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.ReadIpcArgs{
+				path: makeRawStr(path), // path captured by closure
+			})
+		},
+	}
+
+	return &DataFrame{
+		handle:     C.PolarsHandle{handle: C.uintptr_t(0), context_type: C.uint32_t(0)}, // Lazy - no handle yet
+		operations: []Operation{op},
+	}
+}
+
+// JSONOptions configures JSON and NDJSON reading options
+type JSONOptions struct {
+	InferSchemaLength int  // Rows used to infer the schema (0 = 100 rows, negative = all rows)
+	WithGlob          bool // Whether to expand glob patterns
+}
+
+// ReadJSON creates a DataFrame from a JSON file holding an array of row objects
+// Uses default options: the schema is inferred from the first 100 rows and globs such as
+// "exports/*.json" are expanded, with matching files combined in path order.
+func ReadJSON(path string) *DataFrame {
+	return ReadJSONWithOptions(path, JSONOptions{WithGlob: true})
+}
+
+// ReadJSONWithOptions creates a DataFrame from a JSON array file with configurable options
+// Unlike NDJSON, a JSON array is parsed in full when the chain executes.
+func ReadJSONWithOptions(path string, options JSONOptions) *DataFrame {
+	return readJSON(OpReadJson, "ReadJSON()", path, options)
+}
+
+// ReadNDJSON creates a DataFrame from a newline-delimited JSON file (one object per line)
+// Uses the same defaults as ReadJSON; the file is scanned lazily like ReadCSV.
+// Example: ReadNDJSON("logs/2024-*.ndjson").Filter(Col("level").Eq(Lit("error")))
+func ReadNDJSON(path string) *DataFrame {
+	return ReadNDJSONWithOptions(path, JSONOptions{WithGlob: true})
+}
+
+// ReadNDJSONWithOptions creates a DataFrame from an NDJSON file with configurable options
+func ReadNDJSONWithOptions(path string, options JSONOptions) *DataFrame {
+	return readJSON(OpReadNdjson, "ReadNDJSON()", path, options)
+}
+
+func readJSON(opcode uint32, source, path string, options JSONOptions) *DataFrame {
+	inferSchemaLength := options.InferSchemaLength
+	switch {
+	case inferSchemaLength == 0:
+		inferSchemaLength = 100
+	case inferSchemaLength < 0:
+		inferSchemaLength = 0 // Rust treats 0 as "all rows"
+	}
+
+	op := Operation{
+		opcode: opcode,
+		source: source,
+		args: func() unsafe.Pointer {
+			// Globs are expanded when the chain executes, so building the reader does no I/O
+			pathsPtr, count := makeRawStrArray(jsonPaths(path, options.WithGlob))
+			return unsafe.Pointer(&C.ReadJsonArgs{
+				pattern:             makeRawStr(path),
+				paths:               pathsPtr,
+				count:               count,
+				infer_schema_length: C.size_t(inferSchemaLength),
+			})
+		},
+	}
+
+	return &DataFrame{
+		handle:     C.PolarsHandle{handle: C.uintptr_t(0), context_type: C.uint32_t(0)}, // Lazy - no handle yet
+		operations: []Operation{op},
+	}
+}
+
+// jsonPaths expands a JSON glob into its matches in lexical order (none for a malformed
+// pattern); other paths are returned as-is
+func jsonPaths(path string, withGlob bool) []string {
+	if !withGlob || !strings.ContainsAny(path, "*?[") {
+		return []string{path}
+	}
+	matches, _ := filepath.Glob(path) // The only error is a malformed pattern, which matches nothing
+	return matches
+}
+
 // Execute materializes the DataFrame by executing the operation stack.
 // Returns this DataFrame with updated handle, leaving operations cleared.
 // Collect processes all accumulated operations and materializes the result
 // This is where lazy operations are executed and the DataFrame is materialized
 func (df *DataFrame) Collect() (*DataFrame, error) {
 	// Add a Collect operation to the chain
-	df.operations = append(df.operations, Operation{
+	return df.collect(C.CollectArgs{}, "Collect()")
+}
+
+// CollectStreaming is Collect on Polars' streaming engine, which processes the input in
+// batches so large group-bys and joins do not materialize every row at once
+// Scans, filters, projections, group-by aggregations and inner/left joins stream. Parts of the
+// plan the engine does not support yet - window expressions (Over), pivots, as-of joins and
+// order-dependent expressions such as Shift or Cumsum - silently run on the default
+// in-memory engine instead. The result is the same as Collect.
+func (df *DataFrame) CollectStreaming() (*DataFrame, error) {
+	return df.collect(C.CollectArgs{streaming: true}, "CollectStreaming()")
+}
+
+// CollectCtx is Collect that stops the query when ctx is cancelled or times out
+// The query runs on a Polars background thread that is told to stop as soon as ctx is done;
+// Polars checks between execution steps, so a long step (e.g. a single large sort) finishes
+// before the query stops. A cancelled collect returns ctx.Err(), and a query that completes
+// before cancellation takes effect returns its result as usual. Only the collect itself is
+// cancellable: eager reads earlier in the chain (ReadJSON, ReadCSVBytes) are not.
+// Example: ctx, cancel := context.WithTimeout(ctx, time.Minute); defer cancel(); df.CollectCtx(ctx)
+func (df *DataFrame) CollectCtx(ctx context.Context) (*DataFrame, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	token := C.new_cancel_token()
+	defer C.free_cancel_token(token)
+
+	// Watch ctx until the collect returns; wait for the watcher before freeing the token
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			C.cancel_token(token)
+		case <-stop:
+		}
+	}()
+
+	result, err := df.collect(C.CollectArgs{cancel_token: token}, "CollectCtx()")
+	close(stop)
+	<-stopped
+
+	var polarsErr *Error
+	if errors.As(err, &polarsErr) && polarsErr.Code == 6 { // ERROR_CANCELLED
+		return nil, ctx.Err()
+	}
+	return result, err
+}
+
+func (df *DataFrame) collect(args C.CollectArgs, source string) (*DataFrame, error) {
+	df.appendOps(source, Operation{
 		opcode: OpCollect,
-		args:   noArgs,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&args)
+		},
+	})
+	
+	return df.execute()
+}
+
+// Fetch executes the plan against only the first n rows of each source, for fast previews
+// Fetch is approximate: it limits the input, not the output, so a filtered chain may
+// return fewer than n rows (or none) even when the full result has more. Use Limit()
+// followed by Collect() when an exact row count matters.
+func (df *DataFrame) Fetch(n int) (*DataFrame, error) {
+	if n <= 0 {
+		return nil, errors.New("Fetch() requires n > 0")
+	}
+	
+	df.appendOps("Fetch()", Operation{
+		opcode: OpFetch,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.FetchArgs{
+				n_rows: C.size_t(n),
+			})
+		},
 	})
 	
 	return df.execute()
 }
 
+// Evaluate applies each named expression as a column aliased to its map key and collects
+// Columns appear in sorted key order, since Go map iteration order is random.
+// Example: df.Evaluate(map[string]*ExprNode{"avg_salary": Col("salary").Mean()})
+func (df *DataFrame) Evaluate(exprs map[string]*ExprNode) (*DataFrame, error) {
+	if len(exprs) == 0 {
+		return nil, errors.New("Evaluate() requires at least one expression")
+	}
+
+	names := make([]string, 0, len(exprs))
+	for name, expr := range exprs {
+		if expr == nil {
+			return nil, fmt.Errorf("Evaluate(): expression %q is nil", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	aliased := make([]*ExprNode, len(names))
+	for i, name := range names {
+		aliased[i] = exprs[name].Alias(name)
+	}
+
+	return df.SelectExpr(aliased...).Collect()
+}
+
+// CorrMethod selects the correlation coefficient used by CorrMatrix
+type CorrMethod int
+
+const (
+	CorrPearson  CorrMethod = C.CORR_METHOD_PEARSON  // Linear (Pearson) correlation
+	CorrSpearman CorrMethod = C.CORR_METHOD_SPEARMAN // Rank (Spearman) correlation
+)
+
+// CorrMatrix collects the NxN correlation matrix across all numeric columns
+// The first column, "column", labels each row; the remaining columns are named after
+// the numeric columns in schema order, so the result is symmetric around its diagonal.
+func (df *DataFrame) CorrMatrix(method CorrMethod) (*DataFrame, error) {
+	df.appendOps("CorrMatrix()", Operation{
+		opcode: OpCorrMatrix,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.CorrMatrixArgs{
+				method: C.uint32_t(method),
+			})
+		},
+	})
+
+	return df.execute()
+}
+
 func (df *DataFrame) execute() (*DataFrame, error) {
 	if len(df.operations) == 0 {
 		return nil, errors.New("no operations to execute")
@@ -210,23 +711,17 @@ func (df *DataFrame) execute() (*DataFrame, error) {
 	for i, op := range df.operations {
 		// Check if this operation has an error
 		if op.err != nil {
+			releaseRetained(df.operations) // Nothing is sent to Rust
 			return nil, &Error{
-				Code:    4, // ERROR_POLARS_OPERATION
-				Message: op.err.Error(),
-				Frame:   i,
+				Code:      4, // ERROR_POLARS_OPERATION
+				Message:   op.err.Error(),
+				Frame:     i,
+				Source:    op.source,
+				UserFrame: op.call,
 			}
 		}
 		
-		// Call the args function to get the actual args (lazy allocation)
-		var argsPtr unsafe.Pointer
-		if op.args != nil {
-			argsPtr = op.args() // Direct unsafe.Pointer, no type switch needed!
-		}
-		
-		cOps[i] = C.Operation{
-			opcode: C.uint32_t(op.opcode),
-			args:   C.uintptr_t(uintptr(argsPtr)),
-		}
+		cOps[i] = op.cOperation()
 	}
 	
 	// Single FFI call with the entire operation array
@@ -239,10 +734,18 @@ func (df *DataFrame) execute() (*DataFrame, error) {
 	if result.error_code != 0 {
 		errorMsg := C.GoString(result.error_message)
 		C.free_string(result.error_message)
+		frame := int(result.error_frame)
+		var failed Operation
+		if frame < len(df.operations) {
+			failed = df.operations[frame]
+			releaseRetained(df.operations[frame+1:]) // Rust stopped before running these
+		}
 		return nil, &Error{
-			Code:    int(result.error_code),
-			Message: errorMsg,
-			Frame:   int(result.error_frame),
+			Code:      int(result.error_code),
+			Message:   errorMsg,
+			Frame:     frame,
+			Source:    failed.source,
+			UserFrame: failed.call,
 		}
 	}
 	
@@ -273,14 +776,14 @@ func (df *DataFrame) Select(args ...any) *DataFrame {
 	// Add all expression operations first
 	for _, expr := range exprs {
 		for exprOp := range expr.ops {
-			df.operations = append(df.operations, exprOp)
+			df.appendOps("Select()", exprOp)
 		}
 		// Consume the expression to prevent reuse
 		expr.consume()
 	}
 	
 	// Add the select_expr operation
-	df.operations = append(df.operations, Operation{
+	df.appendOps("Select()", Operation{
 		opcode: OpSelectExpr,
 		args:   noArgs,
 	})
@@ -293,14 +796,14 @@ func (df *DataFrame) SelectExpr(exprs ...*ExprNode) *DataFrame {
 	// Add all expression operations first
 	for _, expr := range exprs {
 		for exprOp := range expr.ops {
-			df.operations = append(df.operations, exprOp)
+			df.appendOps("SelectExpr()", exprOp)
 		}
 		// Consume the expression to prevent reuse
 		expr.consume()
 	}
 	
 	// Add the select_expr operation
-	df.operations = append(df.operations, Operation{
+	df.appendOps("SelectExpr()", Operation{
 		opcode: OpSelectExpr,
 		args:   noArgs,
 	})
@@ -315,10 +818,17 @@ func (df *DataFrame) Count() *DataFrame {
 		args:   func() unsafe.Pointer { return unsafe.Pointer(&C.CountArgs{}) }, // Lazy allocation
 	}
 	
-	df.operations = append(df.operations, op)
+	df.appendOps("Count()", op)
 	return df
 }
 
+// IsLazy reports whether the DataFrame is still a query plan rather than materialized data
+// It is true while builder calls are pending or the handle's context_type is a LazyFrame,
+// and false once Collect has produced a DataFrame.
+func (df *DataFrame) IsLazy() bool {
+	return len(df.operations) > 0 || df.handle.context_type == C.CONTEXT_LAZYFRAME
+}
+
 // Height returns the number of rows in the DataFrame as an integer
 // This requires the DataFrame to be executed first
 func (df *DataFrame) Height() (int, error) {
@@ -330,9 +840,58 @@ func (df *DataFrame) Height() (int, error) {
 	return int(height), nil
 }
 
+// Width returns the number of columns in the DataFrame
+// This requires the DataFrame to be executed first
+func (df *DataFrame) Width() (int, error) {
+	if df.handle.handle == 0 {
+		return 0, errors.New("DataFrame must be executed before calling Width()")
+	}
+
+	return int(C.dataframe_width(df.handle.handle)), nil
+}
+
+// Columns returns the column names of the DataFrame in order
+// This requires the DataFrame to be executed first
+// Example: names, err := result.Columns(); for _, name := range names { ... }
+func (df *DataFrame) Columns() ([]string, error) {
+	if df.handle.handle == 0 {
+		return nil, errors.New("DataFrame must be executed before calling Columns()")
+	}
+
+	width := int(C.dataframe_width(df.handle.handle))
+	names := make([]string, width)
+	for i := range names {
+		name := C.dataframe_column_name(df.handle.handle, C.size_t(i))
+		names[i] = C.GoStringN(name.data, C.int(name.len)) // Copy out of the borrowed Rust memory
+	}
+	return names, nil
+}
+
 // Concat concatenates multiple executed DataFrames vertically (union)
 // All DataFrames must be executed before calling this function
 func Concat(dataframes ...*DataFrame) *DataFrame {
+	return concatFrames(dataframes, C.CONCAT_HOW_VERTICAL, "Concat()")
+}
+
+// ConcatHorizontal stacks the columns of multiple executed DataFrames side by side
+// All DataFrames must have the same height and distinct column names; mismatched heights
+// are an error rather than padded with nulls.
+// Example: ConcatHorizontal(people, scores) bolts score columns computed elsewhere onto the same rows
+func ConcatHorizontal(dataframes ...*DataFrame) *DataFrame {
+	return concatFrames(dataframes, C.CONCAT_HOW_HORIZONTAL, "ConcatHorizontal()")
+}
+
+// ConcatDiagonal stacks the rows of multiple executed DataFrames whose schemas differ
+// The result has the union of all columns in first-seen order; columns missing from a frame
+// are filled with nulls, and a column with different dtypes across frames is cast to their
+// common supertype (e.g. i64 and f64 become f64).
+// Example: ConcatDiagonal(january, february) after february gained a "returns" column
+func ConcatDiagonal(dataframes ...*DataFrame) *DataFrame {
+	return concatFrames(dataframes, C.CONCAT_HOW_DIAGONAL, "ConcatDiagonal()")
+}
+
+// concatFrames creates a lazy DataFrame concatenating the executed frames with a CONCAT_HOW_* strategy
+func concatFrames(dataframes []*DataFrame, how C.uint32_t, source string) *DataFrame {
 	if len(dataframes) == 0 {
 		return NewDataFrame() // Return empty DataFrame
 	}
@@ -340,6 +899,7 @@ func Concat(dataframes ...*DataFrame) *DataFrame {
 	// Create operation that will concatenate the DataFrames
 	op := Operation{
 		opcode: OpConcat,
+		source: source,
 		args: func() unsafe.Pointer {
 			// Create array of handles
 			handles := make([]C.uintptr_t, len(dataframes))
@@ -355,6 +915,7 @@ func Concat(dataframes ...*DataFrame) *DataFrame {
 			return unsafe.Pointer(&C.ConcatArgs{
 				handles: (*C.uintptr_t)(unsafe.Pointer(&handles[0])),
 				count:   C.size_t(len(handles)),
+				how:     how,
 			})
 		},
 	}
@@ -374,14 +935,14 @@ func (df *DataFrame) WithColumns(args ...any) *DataFrame {
 	// Add all expression operations first
 	for _, expr := range exprs {
 		for exprOp := range expr.ops {
-			df.operations = append(df.operations, exprOp)
+			df.appendOps("WithColumns()", exprOp)
 		}
 		// Consume the expression to prevent reuse
 		expr.consume()
 	}
 	
 	// Add a single with_column operation (this consumes ALL expressions from the stack)
-	df.operations = append(df.operations, Operation{
+	df.appendOps("WithColumns()", Operation{
 		opcode: OpWithColumn,
 		args:   noArgs,
 	})
@@ -389,6 +950,38 @@ func (df *DataFrame) WithColumns(args ...any) *DataFrame {
 	return df
 }
 
+// WithConstant adds a column holding the same literal value on every row
+// The literal is broadcast to the frame height; supported values are int, int64, float64, string and bool
+// Example: df.WithConstant("source", "sample")
+func (df *DataFrame) WithConstant(name string, value any) *DataFrame {
+	switch value.(type) {
+	case int, int64, float64, string, bool:
+		return df.WithColumns(Lit(value).Alias(name))
+	default:
+		return df.appendErrOpf("WithConstant(): unsupported literal type %T", value)
+	}
+}
+
+// FillNull replaces nulls with a literal value in the columns of the value's type family
+// Ints and floats fill numeric columns, strings fill String columns and bools fill Boolean
+// columns; other columns keep their nulls and dtypes, as with Polars' fill_null. A float
+// value casts the integer columns it fills to Float64.
+// Example: df.FillNull(0)
+func (df *DataFrame) FillNull(value any) *DataFrame {
+	var columns Selector
+	switch value.(type) {
+	case int, int64, float64:
+		columns = NumericSelector()
+	case string:
+		columns = StringSelector()
+	case bool:
+		columns = DtypeSelector(Boolean)
+	default:
+		return df.appendErrOpf("FillNull(): unsupported literal type %T", value)
+	}
+	return df.WithColumns(dtypeCols(columns).FillNull(Lit(value)))
+}
+
 // Filter applies an expression as a filter to the DataFrame
 // Strings are automatically converted to SQL expressions, ExprNodes are used as-is
 // Example: df.Filter("age > 30") or df.Filter(Col("age").Gt(Lit(30)))
@@ -425,7 +1018,7 @@ func (df *DataFrame) Filter(arg any) *DataFrame {
 		},
 	}
 	
-	df.operations = append(df.operations, op)
+	df.appendOps("Filter()", op)
 	return df
 }
 
@@ -442,22 +1035,40 @@ func (df *DataFrame) GroupBy(args ...any) *DataFrame {
 	if len(args) == 0 {
 		return df.appendErrOp("GroupBy() requires at least one expression")
 	}
-	
+	return df.groupBy(args, false, "GroupBy()")
+}
+
+// GroupByStable groups like GroupBy, but Agg() emits groups in the order their keys first
+// appear in the input, so results are deterministic without a Sort()
+// Keeping the order costs extra work after the (parallel) aggregation, so prefer GroupBy
+// when the output is sorted or its order does not matter.
+// Example: df.GroupByStable("department").Agg(Col("salary").Max())
+func (df *DataFrame) GroupByStable(args ...any) *DataFrame {
+	if len(args) == 0 {
+		return df.appendErrOp("GroupByStable() requires at least one expression")
+	}
+	return df.groupBy(args, true, "GroupByStable()")
+}
+
+// groupBy appends the key expressions followed by the group_by operation
+func (df *DataFrame) groupBy(args []any, maintainOrder bool, source string) *DataFrame {
 	exprs := toExprNodes(args...)
 	
 	// Add all expression operations first
 	for _, expr := range exprs {
 		for exprOp := range expr.ops {
-			df.operations = append(df.operations, exprOp)
+			df.appendOps(source, exprOp)
 		}
 		// Consume the expression to prevent reuse
 		expr.consume()
 	}
 	
 	// Add the group_by operation
-	df.operations = append(df.operations, Operation{
+	df.appendOps(source, Operation{
 		opcode: OpGroupBy,
-		args:   noArgs,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.GroupByArgs{maintain_order: C.bool(maintainOrder)})
+		},
 	})
 	
 	return df
@@ -477,14 +1088,14 @@ func (df *DataFrame) Agg(args ...any) *DataFrame {
 	// Add all expression operations first (like WithColumns)
 	for _, expr := range exprs {
 		for exprOp := range expr.ops {
-			df.operations = append(df.operations, exprOp)
+			df.appendOps("Agg()", exprOp)
 		}
 		// Consume the expression to prevent reuse
 		expr.consume()
 	}
 	
 	// Add a single agg operation (this consumes ALL expressions from the stack)
-	df.operations = append(df.operations, Operation{
+	df.appendOps("Agg()", Operation{
 		opcode: OpAgg,
 		args:   noArgs,
 	})
@@ -506,40 +1117,253 @@ func (df *DataFrame) Sort(columns []string) *DataFrame {
 		fields[i] = Asc(col)
 	}
 	
-	return df.SortBy(fields)
+	return df.sortBy(fields, false, "Sort()")
 }
 
 // SortBy sorts the DataFrame by the specified sort fields
+// The relative order of rows with equal keys is not guaranteed; use SortByStable when it matters
 func (df *DataFrame) SortBy(fields []SortField) *DataFrame {
 	if len(fields) == 0 {
 		return df.appendErrOp("SortBy() requires at least one sort field")
 	}
-	
+	return df.sortBy(fields, false, "SortBy()")
+}
+
+// SortByStable sorts the DataFrame by the specified sort fields, keeping rows with
+// equal keys in their original relative order
+func (df *DataFrame) SortByStable(fields []SortField) *DataFrame {
+	if len(fields) == 0 {
+		return df.appendErrOp("SortByStable() requires at least one sort field")
+	}
+	return df.sortBy(fields, true, "SortByStable()")
+}
+
+// sortBy appends a sort operation for the source builder; maintainOrder requests a stable sort
+func (df *DataFrame) sortBy(fields []SortField, maintainOrder bool, source string) *DataFrame {
 	op := Operation{
 		opcode: OpSort,
 		args: func() unsafe.Pointer {
-			// Convert SortField slice to C array
-			cFields := make([]C.SortField, len(fields))
-			for i, field := range fields {
-				columnData := unsafe.StringData(field.Column)
-				cFields[i] = C.SortField{
-					column: C.RawStr{
-						data: (*C.char)(unsafe.Pointer(columnData)),
-						len:  C.size_t(len(field.Column)),
-					},
-					direction:      C.SortDirection(field.Direction),
-					nulls_ordering: C.NullsOrdering(field.NullsOrdering),
-				}
-			}
-			
 			return unsafe.Pointer(&C.SortArgs{
-				fields:      &cFields[0],
-				field_count: C.int(len(fields)),
+				fields:         makeSortFieldArray(fields),
+				field_count:    C.int(len(fields)),
+				maintain_order: C.bool(maintainOrder),
 			})
 		},
 	}
 	
-	df.operations = append(df.operations, op)
+	df.appendOps(source, op)
+	return df
+}
+
+// makeSortFieldArray converts a SortField slice to a C array (nil for an empty slice)
+func makeSortFieldArray(fields []SortField) *C.SortField {
+	if len(fields) == 0 {
+		return nil
+	}
+	cFields := make([]C.SortField, len(fields))
+	for i, field := range fields {
+		columnData := unsafe.StringData(field.Column)
+		cFields[i] = C.SortField{
+			column: C.RawStr{
+				data: (*C.char)(unsafe.Pointer(columnData)),
+				len:  C.size_t(len(field.Column)),
+			},
+			direction:      C.SortDirection(field.Direction),
+			nulls_ordering: C.NullsOrdering(field.NullsOrdering),
+		}
+	}
+	return &cFields[0]
+}
+
+// UniqueKeep selects which row Unique keeps for each duplicated key
+type UniqueKeep int
+
+const (
+	KeepFirst UniqueKeep = C.UNIQUE_KEEP_FIRST // Keep the first row per key
+	KeepLast  UniqueKeep = C.UNIQUE_KEEP_LAST  // Keep the last row per key
+	KeepAny   UniqueKeep = C.UNIQUE_KEEP_ANY   // Keep any row per key (fastest)
+	KeepNone  UniqueKeep = C.UNIQUE_KEEP_NONE  // Drop every row whose key is duplicated
+)
+
+// ParseUniqueKeep maps a Polars keep strategy name ("first", "last", "any", "none") to its UniqueKeep
+// Example: keep, err := ParseUniqueKeep("first"); df.Unique([]string{"email"}, keep)
+func ParseUniqueKeep(name string) (UniqueKeep, error) {
+	switch name {
+	case "first":
+		return KeepFirst, nil
+	case "last":
+		return KeepLast, nil
+	case "any":
+		return KeepAny, nil
+	case "none":
+		return KeepNone, nil
+	default:
+		return 0, fmt.Errorf("unknown unique keep strategy %q (want first, last, any or none)", name)
+	}
+}
+
+// Unique removes duplicate rows, comparing only the subset columns (nil = all columns)
+// The surviving rows keep their original relative order. KeepFirst and KeepLast depend
+// on the incoming row order; use UniqueSortedBy for a deterministic choice.
+func (df *DataFrame) Unique(subset []string, keep UniqueKeep) *DataFrame {
+	return df.unique(subset, keep, nil, "Unique()")
+}
+
+// UniqueSortedBy removes duplicate rows on the subset columns, keeping the first row per
+// key after ordering by the given sort fields
+// Example: df.UniqueSortedBy([]string{"id"}, Desc("updated_at")) keeps the latest record per id
+func (df *DataFrame) UniqueSortedBy(subset []string, order ...SortField) *DataFrame {
+	if len(order) == 0 {
+		return df.appendErrOp("UniqueSortedBy() requires at least one sort field")
+	}
+	return df.unique(subset, KeepFirst, order, "UniqueSortedBy()")
+}
+
+// unique appends a unique operation for the source builder; order (if any) is applied as a stable sort first
+func (df *DataFrame) unique(subset []string, keep UniqueKeep, order []SortField, source string) *DataFrame {
+	op := Operation{
+		opcode: OpUnique,
+		args: func() unsafe.Pointer {
+			subsetPtr, subsetCount := makeRawStrArray(subset)
+			return unsafe.Pointer(&C.UniqueArgs{
+				subset:       subsetPtr,
+				subset_count: subsetCount,
+				keep:         C.uint32_t(keep),
+				order:        makeSortFieldArray(order),
+				order_count:  C.int(len(order)),
+			})
+		},
+	}
+
+	df.appendOps(source, op)
+	return df
+}
+
+// DropNulls removes rows with a null in any of the subset columns (no subset = any column)
+// Example: ReadCSV("people.csv").DropNulls("age", "salary")
+func (df *DataFrame) DropNulls(subset ...string) *DataFrame {
+	op := Operation{
+		opcode: OpDropNulls,
+		args: func() unsafe.Pointer {
+			subsetPtr, subsetCount := makeRawStrArray(subset)
+			return unsafe.Pointer(&C.DropNullsArgs{
+				subset:       subsetPtr,
+				subset_count: subsetCount,
+			})
+		},
+	}
+
+	df.appendOps("DropNulls()", op)
+	return df
+}
+
+// Drop removes the named columns; every column must exist
+// Example: ReadCSV(path).Drop("internal_id", "notes")
+func (df *DataFrame) Drop(columns ...string) *DataFrame {
+	if len(columns) == 0 {
+		return df.appendErrOp("Drop() requires at least one column")
+	}
+
+	op := Operation{
+		opcode: OpDrop,
+		args: func() unsafe.Pointer {
+			columnsPtr, columnCount := makeRawStrArray(columns)
+			return unsafe.Pointer(&C.DropArgs{
+				columns:      columnsPtr,
+				column_count: columnCount,
+			})
+		},
+	}
+
+	df.appendOps("Drop()", op)
+	return df
+}
+
+// Unnest expands struct columns into top-level columns, one per field, in place
+// Example: ReadNDJSON("events.ndjson").Unnest("user") turns user{name, city} into name and city
+func (df *DataFrame) Unnest(columns ...string) *DataFrame {
+	if len(columns) == 0 {
+		return df.appendErrOp("Unnest() requires at least one column")
+	}
+
+	op := Operation{
+		opcode: OpUnnest,
+		args: func() unsafe.Pointer {
+			columnsPtr, columnCount := makeRawStrArray(columns)
+			return unsafe.Pointer(&C.UnnestArgs{
+				columns:      columnsPtr,
+				column_count: columnCount,
+			})
+		},
+	}
+
+	df.appendOps("Unnest()", op)
+	return df
+}
+
+// Rename renames columns using an old -> new name mapping
+// Renaming a missing column, or onto a name that is still in use, fails at collect time.
+// Example: df.Rename(map[string]string{"high_temp": "temperature"})
+func (df *DataFrame) Rename(mapping map[string]string) *DataFrame {
+	if len(mapping) == 0 {
+		return df.appendErrOp("Rename() requires at least one column")
+	}
+
+	// Sort for a deterministic operation (and error) order
+	existing := make([]string, 0, len(mapping))
+	for old := range mapping {
+		existing = append(existing, old)
+	}
+	sort.Strings(existing)
+	renamed := make([]string, len(existing))
+	for i, old := range existing {
+		renamed[i] = mapping[old]
+	}
+
+	return df.rename(existing, renamed, "", "", "Rename()")
+}
+
+// WithColumnRenamed renames a single column
+// Example: df.WithColumnRenamed("high_temp", "temperature")
+func (df *DataFrame) WithColumnRenamed(old, new string) *DataFrame {
+	return df.rename([]string{old}, []string{new}, "", "", "WithColumnRenamed()")
+}
+
+// PrefixColumns renames every column by prepending prefix, keeping the column order
+// Example: orders.PrefixColumns("order_") before joining against another frame
+func (df *DataFrame) PrefixColumns(prefix string) *DataFrame {
+	if prefix == "" {
+		return df.appendErrOp("PrefixColumns() requires a non-empty prefix")
+	}
+	return df.rename(nil, nil, prefix, "", "PrefixColumns()")
+}
+
+// SuffixColumns renames every column by appending suffix, keeping the column order
+func (df *DataFrame) SuffixColumns(suffix string) *DataFrame {
+	if suffix == "" {
+		return df.appendErrOp("SuffixColumns() requires a non-empty suffix")
+	}
+	return df.rename(nil, nil, "", suffix, "SuffixColumns()")
+}
+
+// rename appends a rename operation; with no explicit names prefix/suffix apply to every column
+func (df *DataFrame) rename(existing, renamed []string, prefix, suffix string, source string) *DataFrame {
+	op := Operation{
+		opcode: OpRename,
+		args: func() unsafe.Pointer {
+			existingPtr, count := makeRawStrArray(existing)
+			renamedPtr, _ := makeRawStrArray(renamed)
+			return unsafe.Pointer(&C.RenameArgs{
+				existing: existingPtr,
+				renamed:  renamedPtr,
+				count:    count,
+				prefix:   makeRawStr(prefix),
+				suffix:   makeRawStr(suffix),
+			})
+		},
+	}
+
+	df.appendOps(source, op)
 	return df
 }
 
@@ -558,14 +1382,160 @@ func (df *DataFrame) Limit(n int) *DataFrame {
 		},
 	}
 	
-	df.operations = append(df.operations, op)
+	df.appendOps("Limit()", op)
+	return df
+}
+
+// Head limits the DataFrame to the first n rows (same as Limit)
+func (df *DataFrame) Head(n int) *DataFrame {
+	if n <= 0 {
+		return df.appendErrOp("Head() requires n > 0")
+	}
+	return df.Limit(n)
+}
+
+// Tail limits the DataFrame to the last n rows
+// Example: df.Sort([]string{"salary"}).Tail(3) keeps the three highest salaries
+func (df *DataFrame) Tail(n int) *DataFrame {
+	if n <= 0 {
+		return df.appendErrOp("Tail() requires n > 0")
+	}
+
+	op := Operation{
+		opcode: OpTail,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.LimitArgs{
+				n: C.size_t(n),
+			})
+		},
+	}
+
+	df.appendOps("Tail()", op)
+	return df
+}
+
+// Slice keeps up to length rows starting at offset; a negative offset counts from the end
+// An offset past the last row yields an empty DataFrame rather than an error.
+// Example: df.Slice(page*pageSize, pageSize)
+func (df *DataFrame) Slice(offset, length int) *DataFrame {
+	if length < 0 {
+		return df.appendErrOp("Slice() requires length >= 0")
+	}
+	if uint64(length) > math.MaxUint32 {
+		// Polars slices by a 32-bit row index and would silently clamp a larger length
+		return df.appendErrOpf("Slice() length %d exceeds the maximum of %d rows", length, uint64(math.MaxUint32))
+	}
+
+	op := Operation{
+		opcode: OpSlice,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.SliceArgs{
+				offset: C.int64_t(offset),
+				length: C.size_t(length),
+			})
+		},
+	}
+
+	df.appendOps("Slice()", op)
+	return df
+}
+
+// Reverse flips the row order
+// Example: df.Sort([]string{"salary"}).Reverse() lists the highest salaries first
+func (df *DataFrame) Reverse() *DataFrame {
+	df.appendOps("Reverse()", Operation{
+		opcode: OpReverse,
+		args:   noArgs,
+	})
+	return df
+}
+
+// NullCount replaces the DataFrame with a single row holding each column's number of nulls (u32)
+// Example: ReadCSV("raw.csv").NullCount() audits missing values before modeling
+func (df *DataFrame) NullCount() *DataFrame {
+	df.appendOps("NullCount()", Operation{
+		opcode: OpNullCount,
+		args:   noArgs,
+	})
+	return df
+}
+
+// ValueCounts replaces the DataFrame with one row per distinct value of column and its count (u32)
+// With normalize the count column is "proportion" (f64 fractions of the row count) instead of "count".
+// With sort the most frequent values come first, ties ordered by value; otherwise the order is unspecified.
+// Example: ReadCSV(path).ValueCounts("department", true, false)
+func (df *DataFrame) ValueCounts(column string, sort bool, normalize bool) *DataFrame {
+	op := Operation{
+		opcode: OpValueCounts,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.ValueCountsArgs{
+				column:    makeRawStr(column),
+				sort:      C.bool(sort),
+				normalize: C.bool(normalize),
+			})
+		},
+	}
+
+	df.appendOps("ValueCounts()", op)
+	return df
+}
+
+// GatherEvery keeps every n-th row starting at offset (rows offset, offset+n, offset+2n, ...)
+// Example: df.GatherEvery(2, 0) keeps the 1st, 3rd, 5th, ... rows
+func (df *DataFrame) GatherEvery(n int, offset int) *DataFrame {
+	if n <= 0 {
+		return df.appendErrOp("GatherEvery() requires n > 0")
+	}
+	if offset < 0 {
+		return df.appendErrOp("GatherEvery() requires offset >= 0")
+	}
+	
+	op := Operation{
+		opcode: OpGatherEvery,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.GatherEveryArgs{
+				n:      C.size_t(n),
+				offset: C.size_t(offset),
+			})
+		},
+	}
+	
+	df.appendOps("GatherEvery()", op)
+	return df
+}
+
+// Sample takes a random sample of rows: n rows, or a fraction of the rows (set exactly one)
+// A fixed seed always produces the same sample of the same input. Without replacement n may
+// not exceed the row count and fraction may not exceed 1. Sample collects its input.
+// Example: df.Sample(0, 0.01, false, 42) keeps a reproducible 1% of the rows
+func (df *DataFrame) Sample(n int, fraction float64, withReplacement bool, seed uint64) *DataFrame {
+	if (n > 0) == (fraction > 0) {
+		return df.appendErrOp("Sample() requires exactly one of n > 0 or fraction > 0")
+	}
+	if n < 0 || fraction < 0 {
+		return df.appendErrOp("Sample() requires non-negative n and fraction")
+	}
+
+	op := Operation{
+		opcode: OpSample,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.SampleArgs{
+				n:                C.size_t(n),
+				fraction:         C.double(fraction),
+				with_replacement: C.bool(withReplacement),
+				seed:             C.uint64_t(seed),
+			})
+		},
+	}
+
+	df.appendOps("Sample()", op)
 	return df
 }
 
 // addNullRowForTesting is an internal helper for testing null handling
 // It adds a single row with null values for all columns
 func (df *DataFrame) addNullRowForTesting() *DataFrame {
-	df.operations = append(df.operations, Operation{
+	df.appendOps("addNullRowForTesting()", Operation{
 		opcode: OpAddNullRow,
 		args:   noArgs,
 	})
@@ -573,20 +1543,58 @@ func (df *DataFrame) addNullRowForTesting() *DataFrame {
 }
 
 // Release manually releases the DataFrame resources
+// Release is idempotent. When several DataFrames share a handle, the underlying data
+// is freed only when the last of them releases it.
 func (df *DataFrame) Release() error {
+	releaseRetained(df.operations)
+	df.operations = nil
 	if df.handle.handle == 0 {
 		return nil // Already released or never executed
 	}
 	
-	result := C.release_dataframe(df.handle.handle)
-	if result != 0 {
-		return errors.New("failed to release dataframe")
-	}
-	
+	handle := df.handle.handle
 	df.handle = C.PolarsHandle{} // Mark as released
+	if result := C.release_dataframe(handle); result != 0 {
+		return errors.New("failed to release dataframe: handle is unknown or already freed")
+	}
 	return nil
 }
 
+// Clone returns an independent DataFrame sharing df's executed data, so one result can
+// feed several pipelines. Executing a DataFrame replaces its handle and clears its operations,
+// so branches must not be built on the same *DataFrame; each Clone() is a separate owner
+// of the data instead. Every clone must be released, and the data is freed only when the
+// last owner (df or any clone) releases it. A DataFrame with pending operations must be
+// collected before it can be cloned.
+// Example: base, _ := df.Collect(); seniors := base.Clone().Filter(Col("age").Gt(Lit(30)))
+func (df *DataFrame) Clone() *DataFrame {
+	if len(df.operations) > 0 {
+		return (&DataFrame{}).appendErrOpf("Clone: DataFrame has pending operations - call Collect() first")
+	}
+	clone, err := df.shareHandle()
+	if err != nil {
+		return (&DataFrame{}).appendErrOpf("Clone: %v", err)
+	}
+	return clone
+}
+
+// shareHandle returns a new DataFrame that co-owns df's executed handle
+// Both DataFrames must be released; the data is freed once, by the last owner.
+func (df *DataFrame) shareHandle() (*DataFrame, error) {
+	if df.handle.handle == 0 {
+		return nil, errors.New("dataframe not executed - call Collect() first")
+	}
+	if C.retain_handle(df.handle.handle) != 0 {
+		return nil, errors.New("failed to retain dataframe: handle is unknown or already freed")
+	}
+	return &DataFrame{handle: df.handle}, nil
+}
+
+// handleRefCount reports how many DataFrames own a handle (0 once freed)
+func handleRefCount(handle C.uintptr_t) int {
+	return int(C.handle_ref_count(handle))
+}
+
 // ToCsv converts an executed DataFrame to a CSV string
 func (df *DataFrame) ToCsv() (string, error) {
 	if df.handle.handle == 0 {
@@ -603,6 +1611,122 @@ func (df *DataFrame) ToCsv() (string, error) {
 	return csvString, nil
 }
 
+// CSVWriteOptions configures CSV serialization
+type CSVWriteOptions struct {
+	OmitHeader bool // Skip the header row
+	Separator  byte // Field separator (0 = ',')
+	// FloatPrecision fixes the digits written after the decimal point for float columns
+	// (0 = shortest form that round-trips). Only WriteCSV applies it.
+	FloatPrecision int
+}
+
+// ToCsvBytes converts an executed DataFrame to CSV bytes
+// Unlike ToCsv, the output is copied once from Rust without a NUL-terminated string round trip.
+func (df *DataFrame) ToCsvBytes(options CSVWriteOptions) ([]byte, error) {
+	if df.handle.handle == 0 {
+		return nil, errors.New("dataframe not executed - call Execute() first")
+	}
+	
+	var data *C.uint8_t
+	var length C.size_t
+	rc := C.dataframe_to_csv_bytes(df.handle.handle, C.bool(!options.OmitHeader),
+		C.uchar(options.Separator), &data, &length)
+	if rc != 0 {
+		return nil, errors.New("failed to convert dataframe to CSV")
+	}
+	defer C.free_bytes(data, length)
+	
+	// C.GoBytes takes a C.int length, which would truncate outputs of 2 GiB or more
+	out := make([]byte, int(length))
+	copy(out, unsafe.Slice((*byte)(unsafe.Pointer(data)), int(length)))
+	return out, nil
+}
+
+// WriteCSVTo writes an executed DataFrame to w as CSV with default options
+func (df *DataFrame) WriteCSVTo(w io.Writer) error {
+	data, err := df.ToCsvBytes(CSVWriteOptions{})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteCSV streams an executed DataFrame to a CSV file at path, replacing any existing file
+// Rust writes the rows straight to disk, so no copy of the output is built in Go memory.
+// Failures such as permission denied or a full disk are returned as *Error with Code 5.
+// Example: err := result.WriteCSV("out/report.csv", CSVWriteOptions{FloatPrecision: 2})
+func (df *DataFrame) WriteCSV(path string, options CSVWriteOptions) error {
+	if df.handle.handle == 0 {
+		return errors.New("dataframe not executed - call Collect() first")
+	}
+	if options.FloatPrecision < 0 {
+		return fmt.Errorf("WriteCSV: FloatPrecision must be non-negative, got %d", options.FloatPrecision)
+	}
+
+	result := C.write_csv(df.handle.handle, makeRawStr(path), C.bool(!options.OmitHeader),
+		C.uchar(options.Separator), C.size_t(options.FloatPrecision))
+	return resultError(result, "WriteCSV()")
+}
+
+// WriteJSON writes an executed DataFrame to path as a JSON array of row objects
+// List and struct columns are written as nested arrays and objects; an empty frame writes [].
+// Example: err := result.WriteJSON("out/summary.json")
+func (df *DataFrame) WriteJSON(path string) error {
+	return df.writeJSON(path, false, "WriteJSON()")
+}
+
+// WriteNDJSON writes an executed DataFrame to path as newline-delimited JSON, one object per row
+// This suits log pipelines that consume a record per line; an empty frame writes an empty file.
+// Example: err := summary.WriteNDJSON("out/summary.ndjson")
+func (df *DataFrame) WriteNDJSON(path string) error {
+	return df.writeJSON(path, true, "WriteNDJSON()")
+}
+
+func (df *DataFrame) writeJSON(path string, ndjson bool, source string) error {
+	if df.handle.handle == 0 {
+		return errors.New("dataframe not executed - call Collect() first")
+	}
+	return resultError(C.write_json(df.handle.handle, makeRawStr(path), C.bool(ndjson)), source)
+}
+
+// ipcCompressions maps WriteIPC compression names to IPC_COMPRESSION_* constants
+var ipcCompressions = map[string]C.uint32_t{
+	"":     C.IPC_COMPRESSION_NONE,
+	"none": C.IPC_COMPRESSION_NONE,
+	"lz4":  C.IPC_COMPRESSION_LZ4,
+	"zstd": C.IPC_COMPRESSION_ZSTD,
+}
+
+// WriteIPC writes an executed DataFrame to path in the Arrow IPC (Feather v2) format
+// compression is "" (or "none"), "lz4" or "zstd". Read the file back with ReadIPC.
+// Failures such as permission denied or a full disk are returned as *Error with Code 5.
+// Example: err := result.WriteIPC("handoff/orders.arrow", "zstd")
+func (df *DataFrame) WriteIPC(path string, compression string) error {
+	if df.handle.handle == 0 {
+		return errors.New("dataframe not executed - call Collect() first")
+	}
+	codec, ok := ipcCompressions[compression]
+	if !ok {
+		return fmt.Errorf("WriteIPC: unknown compression %q (want lz4, zstd or none)", compression)
+	}
+	return resultError(C.write_ipc(df.handle.handle, makeRawStr(path), codec), "WriteIPC()")
+}
+
+// resultError converts a failed FfiResult from a direct (non-chain) FFI call into *Error, freeing the Rust-owned message
+func resultError(result C.FfiResult, source string) error {
+	if result.error_code == 0 {
+		return nil
+	}
+	message := C.GoString(result.error_message)
+	C.free_string(result.error_message)
+	return &Error{
+		Code:    int(result.error_code),
+		Message: message,
+		Source:  source,
+	}
+}
+
 // String implements fmt.Stringer for DataFrame display
 func (df *DataFrame) String() string {
 	if df.handle.handle == 0 {
@@ -624,6 +1748,8 @@ func (df *DataFrame) String() string {
 
 // Query executes a SQL query on the DataFrame
 // The DataFrame is registered as "df" table in the SQL context
+// The query result stays lazy, so further builder calls join the same plan:
+// df.Query("SELECT name, salary FROM df").Filter(Col("salary").Gt(Lit(55000))).Sort([]string{"salary"})
 // Example: df.Query("SELECT name, salary * 1.1 as new_salary FROM df WHERE age > 25")
 func (df *DataFrame) Query(sql string) *DataFrame {
 	op := Operation{
@@ -635,9 +1761,7 @@ func (df *DataFrame) Query(sql string) *DataFrame {
 			return unsafe.Pointer(args)
 		},
 	}
-	
-	return &DataFrame{
-		handle:     df.handle,
-		operations: append(df.operations, op),
-	}
+
+	df.appendOps("Query()", op)
+	return df
 }
@@ -1,7 +1,16 @@
 package polars
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +44,377 @@ func TestBasicOperations(t *testing.T) {
 		require.Equal(t, expected, result.String())
 	})
 
+	t.Run("ReadCSVDecimalComma", func(t *testing.T) {
+		// European formatting: ';' separator, '.' thousands, ',' decimals
+		df := ReadCSVWithConfig("../testdata/eu_prices.csv", CSVReadConfig{
+			HasHeader:    true,
+			DecimalComma: true,
+		})
+		result, err := df.Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: price parses as f64 with thousands separators removed
+		expected := `shape: (3, 3)
+┌─────────┬───────────┬──────────┐
+│ product ┆ price     ┆ quantity │
+│ ---     ┆ ---       ┆ ---      │
+│ str     ┆ f64       ┆ i64      │
+╞═════════╪═══════════╪══════════╡
+│ Widget  ┆ 1234.56   ┆ 3        │
+│ Gadget  ┆ 12.5      ┆ 10       │
+│ Gizmo   ┆ 12345.678 ┆ 1        │
+└─────────┴───────────┴──────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		// Listing the column converts it without detection
+		listed, err := ReadCSVWithConfig("../testdata/eu_prices.csv", CSVReadConfig{
+			HasHeader:           true,
+			DecimalCommaColumns: []string{"price"},
+		}).Collect()
+		require.NoError(t, err)
+		defer listed.Release()
+		require.Equal(t, expected, listed.String())
+
+		// Values that are not numbers fail loudly instead of becoming null
+		_, err = ReadCSVWithConfig("../testdata/eu_prices.csv", CSVReadConfig{
+			HasHeader:           true,
+			DecimalCommaColumns: []string{"product"},
+		}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "conversion")
+
+		_, err = ReadCSVWithConfig("../testdata/eu_prices.csv", CSVReadConfig{
+			HasHeader:           true,
+			DecimalCommaColumns: []string{"price"},
+			SchemaOverrides:     map[string]DataType{"price": String},
+		}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "both DecimalCommaColumns and SchemaOverrides")
+	})
+
+	t.Run("ReadCSVDialect", func(t *testing.T) {
+		// Tab-separated, single-quoted fields and '#' comment lines
+		df := ReadCSVWithConfig("../testdata/visits.tsv", CSVReadConfig{
+			HasHeader: true,
+			Separator: '\t',
+			QuoteChar: '\'',
+			Comment:   '#',
+		})
+		result, err := df.Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: comments are skipped and quoted commas and doubled quotes survive
+		expected := `shape: (3, 3)
+┌──────┬───────────┬────────┐
+│ city ┆ note      ┆ visits │
+│ ---  ┆ ---       ┆ ---    │
+│ str  ┆ str       ┆ i64    │
+╞══════╪═══════════╪════════╡
+│ Oslo ┆ cold, wet ┆ 3      │
+│ Lima ┆ it's dry  ┆ 5      │
+│ Pune ┆ warm      ┆ 2      │
+└──────┴───────────┴────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		data, err := os.ReadFile("../testdata/visits.tsv")
+		require.NoError(t, err)
+		fromBytes, err := ReadCSVBytes(data, CSVReadConfig{
+			HasHeader: true,
+			Separator: '\t',
+			QuoteChar: '\'',
+			Comment:   '#',
+		}).Collect()
+		require.NoError(t, err)
+		defer fromBytes.Release()
+		require.Equal(t, expected, fromBytes.String())
+	})
+
+	t.Run("ReadCSVNullValues", func(t *testing.T) {
+		config := CSVReadConfig{HasHeader: true, NullValues: []string{"NA", "NULL", "-"}}
+		result, err := ReadCSVWithConfig("../testdata/sensors.csv", config).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: sentinel tokens become nulls, so reading is still inferred as f64
+		expected := `shape: (4, 3)
+┌────────┬─────────┬────────┐
+│ sensor ┆ reading ┆ status │
+│ ---    ┆ ---     ┆ ---    │
+│ str    ┆ f64     ┆ str    │
+╞════════╪═════════╪════════╡
+│ a      ┆ 1.5     ┆ ok     │
+│ b      ┆ null    ┆ null   │
+│ c      ┆ null    ┆ ok     │
+│ d      ┆ 2.5     ┆ null   │
+└────────┴─────────┴────────┘`
+		require.Equal(t, expected, result.String())
+
+		counts, err := ReadCSVWithConfig("../testdata/sensors.csv", config).
+			Select(
+				Col("reading").Count().Alias("readings"),
+				Col("reading").CountWithNulls().Alias("rows"),
+			).
+			Collect()
+		require.NoError(t, err)
+		defer counts.Release()
+
+		expected = `shape: (1, 2)
+┌──────────┬──────┐
+│ readings ┆ rows │
+│ ---      ┆ ---  │
+│ u32      ┆ u32  │
+╞══════════╪══════╡
+│ 2        ┆ 4    │
+└──────────┴──────┘`
+		require.Equal(t, expected, counts.String())
+
+		data, err := os.ReadFile("../testdata/sensors.csv")
+		require.NoError(t, err)
+		fromBytes, err := ReadCSVBytes(data, config).Collect()
+		require.NoError(t, err)
+		defer fromBytes.Release()
+		require.Equal(t, result.String(), fromBytes.String())
+
+		// Without NullValues the tokens are plain strings
+		raw, err := ReadCSV("../testdata/sensors.csv").Collect()
+		require.NoError(t, err)
+		defer raw.Release()
+		readings, _, err := raw.ColumnString("reading")
+		require.NoError(t, err)
+		require.Equal(t, []string{"1.5", "NA", "-", "2.5"}, readings)
+	})
+
+	t.Run("ReadCSVSkipAndLimit", func(t *testing.T) {
+		// Two preamble lines before the header and a summary row after it
+		config := CSVReadConfig{HasHeader: true, SkipRows: 2, SkipRowsAfterHeader: 1, NRows: 2}
+		result, err := ReadCSVWithConfig("../testdata/preamble.csv", config).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (2, 2)
+┌───────┬─────┐
+│ name  ┆ age │
+│ ---   ┆ --- │
+│ str   ┆ i64 │
+╞═══════╪═════╡
+│ Alice ┆ 25  │
+│ Bob   ┆ 30  │
+└───────┴─────┘`
+		require.Equal(t, expected, result.String())
+
+		data, err := os.ReadFile("../testdata/preamble.csv")
+		require.NoError(t, err)
+		fromBytes, err := ReadCSVBytes(data, config).Collect()
+		require.NoError(t, err)
+		defer fromBytes.Release()
+		require.Equal(t, expected, fromBytes.String())
+
+		// With a glob, NRows limits the combined rows rather than each file
+		parts, err := ReadCSVWithConfig("../testdata/csv_parts/part-*.csv", CSVReadConfig{
+			HasHeader: true,
+			WithGlob:  true,
+			NRows:     5,
+		}).Collect()
+		require.NoError(t, err)
+		defer parts.Release()
+		names, _, err := parts.ColumnString("name")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Alice", "Bob", "Charlie", "Diana", "Eve"}, names)
+
+		_, err = ReadCSVWithConfig("../testdata/preamble.csv", CSVReadConfig{NRows: -1}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "NRows must not be negative")
+	})
+
+	t.Run("ReadCSVSchemaOverrides", func(t *testing.T) {
+		// Inference reads zip as i64 and drops the leading zeros
+		inferred, err := ReadCSV("../testdata/addresses.csv").Select("zip").Collect()
+		require.NoError(t, err)
+		defer inferred.Release()
+		zips, _, err := inferred.ColumnInt64("zip")
+		require.NoError(t, err)
+		require.Equal(t, []int64{2108, 7102, 78701}, zips)
+
+		config := CSVReadConfig{
+			HasHeader:       true,
+			SchemaOverrides: map[string]DataType{"zip": String, "population": Float64},
+		}
+		result, err := ReadCSVWithConfig("../testdata/addresses.csv", config).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: overridden columns take the forced dtype, city is still inferred
+		expected := `shape: (3, 3)
+┌────────┬───────┬────────────┐
+│ city   ┆ zip   ┆ population │
+│ ---    ┆ ---   ┆ ---        │
+│ str    ┆ str   ┆ f64        │
+╞════════╪═══════╪════════════╡
+│ Boston ┆ 02108 ┆ 4000.0     │
+│ Newark ┆ 07102 ┆ 3000.0     │
+│ Austin ┆ 78701 ┆ 5000.0     │
+└────────┴───────┴────────────┘`
+		require.Equal(t, expected, result.String())
+
+		data, err := os.ReadFile("../testdata/addresses.csv")
+		require.NoError(t, err)
+		fromBytes, err := ReadCSVBytes(data, config).Collect()
+		require.NoError(t, err)
+		defer fromBytes.Release()
+		require.Equal(t, expected, fromBytes.String())
+	})
+
+	t.Run("ReadCSVInferSchemaLength", func(t *testing.T) {
+		// value holds integers for 150 rows and a float in the last one
+		_, err := ReadCSV("../testdata/late_floats.csv").Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "2.5")
+
+		for _, length := range []int{-1, 200} {
+			result, err := ReadCSVWithConfig("../testdata/late_floats.csv", CSVReadConfig{
+				HasHeader:         true,
+				InferSchemaLength: length,
+			}).Collect()
+			require.NoError(t, err)
+
+			values, _, err := result.ColumnFloat64("value")
+			require.NoError(t, err)
+			require.Len(t, values, 151)
+			require.Equal(t, []float64{10, 20}, values[:2])
+			require.Equal(t, 2.5, values[150])
+			result.Release()
+		}
+	})
+
+	t.Run("ReadCSVBytes", func(t *testing.T) {
+		data, err := os.ReadFile("../testdata/sample.csv")
+		require.NoError(t, err)
+
+		fromFile, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		defer fromFile.Release()
+
+		fromBytes, err := ReadCSVBytes(data, CSVReadConfig{HasHeader: true}).Collect()
+		require.NoError(t, err)
+		defer fromBytes.Release()
+
+		fromReader, err := ReadCSVFrom(bytes.NewReader(data), CSVReadConfig{HasHeader: true}).Collect()
+		require.NoError(t, err)
+		defer fromReader.Release()
+
+		// Golden test: in-memory reads match the file-based read exactly
+		require.Equal(t, fromFile.String(), fromBytes.String())
+		require.Equal(t, fromFile.String(), fromReader.String())
+	})
+
+	t.Run("CSVBytesRoundTrip", func(t *testing.T) {
+		original, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		defer original.Release()
+
+		data, err := original.ToCsvBytes(CSVWriteOptions{})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, original.WriteCSVTo(&buf))
+		require.Equal(t, data, buf.Bytes())
+
+		roundTrip, err := ReadCSVBytes(data, CSVReadConfig{HasHeader: true}).Collect()
+		require.NoError(t, err)
+		defer roundTrip.Release()
+
+		// Golden test: serializing and re-parsing preserves the frame
+		require.Equal(t, original.String(), roundTrip.String())
+	})
+
+	t.Run("WriteCSVFile", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			Select("name", Col("salary").Div(Lit(7.0)).Alias("ratio")).
+			Limit(2).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		path := filepath.Join(t.TempDir(), "ratios.csv")
+		require.NoError(t, result.WriteCSV(path, CSVWriteOptions{Separator: '|', FloatPrecision: 2}))
+		written, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "name|ratio\nAlice|7142.86\nBob|8571.43\n", string(written))
+
+		require.NoError(t, result.WriteCSV(path, CSVWriteOptions{OmitHeader: true}))
+		written, err = os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "Alice,7142.857142857143\nBob,8571.428571428572\n", string(written))
+
+		// I/O failures come back as *Error
+		err = result.WriteCSV(filepath.Join(t.TempDir(), "missing", "out.csv"), CSVWriteOptions{})
+		var polarsErr *Error
+		require.ErrorAs(t, err, &polarsErr)
+		require.Equal(t, 5, polarsErr.Code)
+		require.Equal(t, "WriteCSV()", polarsErr.Source)
+		require.Contains(t, polarsErr.Message, "cannot create")
+
+		// The frame must be executed first
+		lazy := ReadCSV("../testdata/sample.csv")
+		defer lazy.Release()
+		require.Error(t, lazy.WriteCSV(path, CSVWriteOptions{}))
+	})
+
+	t.Run("WriteJSONFiles", func(t *testing.T) {
+		summary, err := ReadCSV("../testdata/sample.csv").
+			GroupBy("department").
+			Agg(Col("name"), Col("salary").Max().Alias("top_salary")).
+			Sort([]string{"department"}).
+			Collect()
+		require.NoError(t, err)
+		defer summary.Release()
+
+		dir := t.TempDir()
+		jsonPath := filepath.Join(dir, "summary.json")
+		require.NoError(t, summary.WriteJSON(jsonPath))
+		data, err := os.ReadFile(jsonPath)
+		require.NoError(t, err)
+
+		// List columns become JSON arrays
+		var rows []map[string]any
+		require.NoError(t, json.Unmarshal(data, &rows))
+		require.Equal(t, []map[string]any{
+			{"department": "Engineering", "name": []any{"Alice", "Charlie", "Eve"}, "top_salary": 70000.0},
+			{"department": "Marketing", "name": []any{"Bob", "Frank"}, "top_salary": 60000.0},
+			{"department": "Sales", "name": []any{"Diana", "Grace"}, "top_salary": 55000.0},
+		}, rows)
+
+		ndjsonPath := filepath.Join(dir, "summary.ndjson")
+		require.NoError(t, summary.WriteNDJSON(ndjsonPath))
+		data, err = os.ReadFile(ndjsonPath)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		require.Len(t, lines, 3)
+		for i, line := range lines {
+			var row map[string]any
+			require.NoError(t, json.Unmarshal([]byte(line), &row))
+			require.Equal(t, rows[i], row)
+		}
+
+		// Empty frames give a valid empty array / empty file
+		empty, err := ReadCSV("../testdata/sample.csv").Filter(Col("age").Gt(Lit(100))).Collect()
+		require.NoError(t, err)
+		defer empty.Release()
+		require.NoError(t, empty.WriteJSON(jsonPath))
+		data, err = os.ReadFile(jsonPath)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &rows))
+		require.Empty(t, rows)
+		require.NoError(t, empty.WriteNDJSON(ndjsonPath))
+		data, err = os.ReadFile(ndjsonPath)
+		require.NoError(t, err)
+		require.Empty(t, data)
+	})
+
 	t.Run("Select", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
 		result, err := df.Select("name", "salary").Collect()
@@ -145,348 +525,2503 @@ func TestExpressions(t *testing.T) {
 		require.Equal(t, expected, result.String())
 	})
 
-	t.Run("WithColumns", func(t *testing.T) {
+	t.Run("InclusiveComparisons", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.WithColumns(
-			Col("salary").Mul(Lit(2)).Alias("double_salary"),
-			Col("age").Add(Lit(10)).Alias("age_plus_10"),
-		).Select("name", "double_salary", "age_plus_10").Collect()
+		result, err := df.SelectExpr(
+			Col("name"),
+			Col("age").Ge(Lit(30)).Alias("age_ge_30"),
+			Col("age").Le(Lit(28)).Alias("age_le_28"),
+			Col("department").Ne(Lit("Sales")).Alias("not_sales"),
+		).Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: computed columns
-		expected := `shape: (7, 3)
-┌─────────┬───────────────┬─────────────┐
-│ name    ┆ double_salary ┆ age_plus_10 │
-│ ---     ┆ ---           ┆ ---         │
-│ str     ┆ i64           ┆ i64         │
-╞═════════╪═══════════════╪═════════════╡
-│ Alice   ┆ 100000        ┆ 35          │
-│ Bob     ┆ 120000        ┆ 40          │
-│ Charlie ┆ 140000        ┆ 45          │
-│ Diana   ┆ 110000        ┆ 38          │
-│ Eve     ┆ 130000        ┆ 42          │
-│ Frank   ┆ 116000        ┆ 39          │
-│ Grace   ┆ 104000        ┆ 37          │
-└─────────┴───────────────┴─────────────┘`
+		// Golden test: boundary values (Bob at 30, Diana at 28) are included
+		expected := `shape: (7, 4)
+┌─────────┬───────────┬───────────┬───────────┐
+│ name    ┆ age_ge_30 ┆ age_le_28 ┆ not_sales │
+│ ---     ┆ ---       ┆ ---       ┆ ---       │
+│ str     ┆ bool      ┆ bool      ┆ bool      │
+╞═════════╪═══════════╪═══════════╪═══════════╡
+│ Alice   ┆ false     ┆ true      ┆ true      │
+│ Bob     ┆ true      ┆ false     ┆ true      │
+│ Charlie ┆ true      ┆ false     ┆ true      │
+│ Diana   ┆ false     ┆ true      ┆ false     │
+│ Eve     ┆ true      ┆ false     ┆ true      │
+│ Frank   ┆ false     ┆ false     ┆ true      │
+│ Grace   ┆ false     ┆ true      ┆ false     │
+└─────────┴───────────┴───────────┴───────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
 
-	t.Run("StringOperations", func(t *testing.T) {
+	t.Run("BetweenInclusive", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.SelectExpr(
-			Col("name").Alias("name"),
-			Col("name").StrLen().Alias("name_length"),
-			Col("name").StrToUppercase().Alias("name_upper"),
-			Col("name").StrContains("a").Alias("contains_a"),
-		).Collect()
+		result, err := df.Filter(Col("salary").Between(Lit(50000), Lit(65000), true)).Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: string operations
-		expected := `shape: (7, 4)
-┌─────────┬─────────────┬────────────┬────────────┐
-│ name    ┆ name_length ┆ name_upper ┆ contains_a │
-│ ---     ┆ ---         ┆ ---        ┆ ---        │
-│ str     ┆ u32         ┆ str        ┆ bool       │
-╞═════════╪═════════════╪════════════╪════════════╡
-│ Alice   ┆ 5           ┆ ALICE      ┆ false      │
-│ Bob     ┆ 3           ┆ BOB        ┆ false      │
-│ Charlie ┆ 7           ┆ CHARLIE    ┆ true       │
-│ Diana   ┆ 5           ┆ DIANA      ┆ true       │
-│ Eve     ┆ 3           ┆ EVE        ┆ false      │
-│ Frank   ┆ 5           ┆ FRANK      ┆ true       │
-│ Grace   ┆ 5           ┆ GRACE      ┆ true       │
-└─────────┴─────────────┴────────────┴────────────┘`
+		// Golden test: both bounds (Alice at 50000, Eve at 65000) are kept
+		expected := `shape: (6, 4)
+┌───────┬─────┬────────┬─────────────┐
+│ name  ┆ age ┆ salary ┆ department  │
+│ ---   ┆ --- ┆ ---    ┆ ---         │
+│ str   ┆ i64 ┆ i64    ┆ str         │
+╞═══════╪═════╪════════╪═════════════╡
+│ Alice ┆ 25  ┆ 50000  ┆ Engineering │
+│ Bob   ┆ 30  ┆ 60000  ┆ Marketing   │
+│ Diana ┆ 28  ┆ 55000  ┆ Sales       │
+│ Eve   ┆ 32  ┆ 65000  ┆ Engineering │
+│ Frank ┆ 29  ┆ 58000  ┆ Marketing   │
+│ Grace ┆ 27  ┆ 52000  ┆ Sales       │
+└───────┴─────┴────────┴─────────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
-}
 
-// TestAggregations demonstrates GroupBy and aggregation operations
-func TestAggregations(t *testing.T) {
-	t.Run("BasicAggregations", func(t *testing.T) {
+	t.Run("BetweenExclusive", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
 		result, err := df.SelectExpr(
-			Col("salary").Sum().Alias("total_salary"),
-			Col("age").Mean().Alias("avg_age"),
-			Col("salary").Min().Alias("min_salary"),
-			Col("salary").Max().Alias("max_salary"),
+			Col("name"),
+			Col("age"),
+			Col("age").Between(Lit(27), Lit(30), false).Alias("late_twenties"),
 		).Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: basic aggregations
-		expected := `shape: (1, 4)
-┌──────────────┬───────────┬────────────┬────────────┐
-│ total_salary ┆ avg_age   ┆ min_salary ┆ max_salary │
-│ ---          ┆ ---       ┆ ---        ┆ ---        │
-│ i64          ┆ f64       ┆ i64        ┆ i64        │
-╞══════════════╪═══════════╪════════════╪════════════╡
-│ 410000       ┆ 29.428571 ┆ 50000      ┆ 70000      │
-└──────────────┴───────────┴────────────┴────────────┘`
+		// Golden test: Grace (27) and Bob (30) sit on the bounds and are excluded
+		expected := `shape: (7, 3)
+┌─────────┬─────┬───────────────┐
+│ name    ┆ age ┆ late_twenties │
+│ ---     ┆ --- ┆ ---           │
+│ str     ┆ i64 ┆ bool          │
+╞═════════╪═════╪═══════════════╡
+│ Alice   ┆ 25  ┆ false         │
+│ Bob     ┆ 30  ┆ false         │
+│ Charlie ┆ 35  ┆ false         │
+│ Diana   ┆ 28  ┆ true          │
+│ Eve     ┆ 32  ┆ false         │
+│ Frank   ┆ 29  ┆ true          │
+│ Grace   ┆ 27  ┆ false         │
+└─────────┴─────┴───────────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
 
-	t.Run("GroupByAggregation", func(t *testing.T) {
+	t.Run("IsIn", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.GroupBy("department").
-			Agg(Col("salary").Mean().Alias("avg_salary")).
-			Sort([]string{"avg_salary"}).
-			Collect()
+		result, err := df.Filter(Col("department").IsIn("Engineering", "Sales")).Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: group by with aggregation
-		expected := `shape: (3, 2)
-┌─────────────┬──────────────┐
-│ department  ┆ avg_salary   │
-│ ---         ┆ ---          │
-│ str         ┆ f64          │
-╞═════════════╪══════════════╡
-│ Sales       ┆ 53500.0      │
-│ Marketing   ┆ 59000.0      │
-│ Engineering ┆ 61666.666667 │
-└─────────────┴──────────────┘`
+		// Golden test: Marketing rows (Bob, Frank) are dropped
+		expected := `shape: (5, 4)
+┌─────────┬─────┬────────┬─────────────┐
+│ name    ┆ age ┆ salary ┆ department  │
+│ ---     ┆ --- ┆ ---    ┆ ---         │
+│ str     ┆ i64 ┆ i64    ┆ str         │
+╞═════════╪═════╪════════╪═════════════╡
+│ Alice   ┆ 25  ┆ 50000  ┆ Engineering │
+│ Charlie ┆ 35  ┆ 70000  ┆ Engineering │
+│ Diana   ┆ 28  ┆ 55000  ┆ Sales       │
+│ Eve     ┆ 32  ┆ 65000  ┆ Engineering │
+│ Grace   ┆ 27  ┆ 52000  ┆ Sales       │
+└─────────┴─────┴────────┴─────────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
 
-	t.Run("MultipleAggregations", func(t *testing.T) {
-		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.GroupBy("department").
-			Agg(
-				Col("salary").Mean().Alias("avg_salary"),
-				Col("name").Count().Alias("employee_count"),
-				Col("age").Max().Alias("max_age"),
-			).
-			Sort([]string{"avg_salary"}).
-			Collect()
+	t.Run("IsInMixedTypes", func(t *testing.T) {
+		_, err := ReadCSV("../testdata/sample.csv").Filter(Col("age").IsIn(25, "thirty")).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "IsIn() values mix int and string")
+	})
+
+	t.Run("FillNullExpressions", func(t *testing.T) {
+		payroll := []byte("name,base,bonus\n" +
+			"Alice,100,10\n" +
+			"Bob,200,\n" +
+			"Carol,,30\n")
+		result, err := ReadCSVBytes(payroll, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("name"),
+			Col("base").FillNull(Lit(0)),
+			Col("bonus").FillNull(Col("base")), // Fill from another column
+		).Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: multiple aggregations
-		expected := `shape: (3, 4)
-┌─────────────┬──────────────┬────────────────┬─────────┐
-│ department  ┆ avg_salary   ┆ employee_count ┆ max_age │
-│ ---         ┆ ---          ┆ ---            ┆ ---     │
-│ str         ┆ f64          ┆ u32            ┆ i64     │
-╞═════════════╪══════════════╪════════════════╪═════════╡
-│ Sales       ┆ 53500.0      ┆ 2              ┆ 28      │
-│ Marketing   ┆ 59000.0      ┆ 2              ┆ 30      │
-│ Engineering ┆ 61666.666667 ┆ 3              ┆ 35      │
-└─────────────┴──────────────┴────────────────┴─────────┘`
+		expected := `shape: (3, 3)
+┌───────┬──────┬───────┐
+│ name  ┆ base ┆ bonus │
+│ ---   ┆ ---  ┆ ---   │
+│ str   ┆ i64  ┆ i64   │
+╞═══════╪══════╪═══════╡
+│ Alice ┆ 100  ┆ 10    │
+│ Bob   ┆ 200  ┆ 200   │
+│ Carol ┆ 0    ┆ 30    │
+└───────┴──────┴───────┘`
 
 		require.Equal(t, expected, result.String())
 	})
-}
 
-// TestSQLExpressions demonstrates the key ...any functionality with SQL strings
-func TestSQLExpressions(t *testing.T) {
-	t.Run("SelectWithMixedSQLAndFluent", func(t *testing.T) {
-		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.Select(
-			"name",                                      // SQL string (column name)
-			"salary * 1.1 as bonus_salary",             // SQL expression with alias
-			Col("age").Add(Lit(5)).Alias("age_plus_5"), // Fluent API
-			"department",                                // SQL string (column name)
-		).Collect()
+	t.Run("FillNullFrame", func(t *testing.T) {
+		withNullRow := func() *DataFrame {
+			collected, err := ReadCSV("../testdata/sample.csv").Limit(2).Collect()
+			require.NoError(t, err)
+			return collected.addNullRowForTesting()
+		}
+
+		numbers, err := withNullRow().FillNull(0).Collect()
+		require.NoError(t, err)
+		defer numbers.Release()
+
+		// Golden test: a number fills only the numeric columns; strings keep their nulls
+		expected := `shape: (3, 4)
+┌───────┬─────┬────────┬─────────────┐
+│ name  ┆ age ┆ salary ┆ department  │
+│ ---   ┆ --- ┆ ---    ┆ ---         │
+│ str   ┆ i64 ┆ i64    ┆ str         │
+╞═══════╪═════╪════════╪═════════════╡
+│ Alice ┆ 25  ┆ 50000  ┆ Engineering │
+│ Bob   ┆ 30  ┆ 60000  ┆ Marketing   │
+│ null  ┆ 0   ┆ 0      ┆ null        │
+└───────┴─────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, numbers.String())
+
+		text, err := withNullRow().FillNull("n/a").Collect()
+		require.NoError(t, err)
+		defer text.Release()
+
+		// Golden test: a string fills only the String columns and leaves the numbers typed
+		expected = `shape: (3, 4)
+┌───────┬──────┬────────┬─────────────┐
+│ name  ┆ age  ┆ salary ┆ department  │
+│ ---   ┆ ---  ┆ ---    ┆ ---         │
+│ str   ┆ i64  ┆ i64    ┆ str         │
+╞═══════╪══════╪════════╪═════════════╡
+│ Alice ┆ 25   ┆ 50000  ┆ Engineering │
+│ Bob   ┆ 30   ┆ 60000  ┆ Marketing   │
+│ n/a   ┆ null ┆ null   ┆ n/a         │
+└───────┴──────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, text.String())
+	})
+
+	t.Run("ForwardBackwardFillOverPartition", func(t *testing.T) {
+		readings := []byte("sensor,reading\n" +
+			"A,10\n" +
+			"A,\n" +
+			"A,\n" +
+			"B,\n" +
+			"B,5\n" +
+			"B,\n")
+		result, err := ReadCSVBytes(readings, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("sensor"),
+			Col("reading"),
+			Col("reading").ForwardFill(0).Over("sensor").Alias("ffill"),
+			Col("reading").ForwardFill(1).Alias("ffill_1"), // Across sensors, one null at most
+			Col("reading").BackwardFill(0).Over("sensor").Alias("bfill"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: fills never cross from sensor A into sensor B under Over()
+		expected := `shape: (6, 5)
+┌────────┬─────────┬───────┬─────────┬───────┐
+│ sensor ┆ reading ┆ ffill ┆ ffill_1 ┆ bfill │
+│ ---    ┆ ---     ┆ ---   ┆ ---     ┆ ---   │
+│ str    ┆ i64     ┆ i64   ┆ i64     ┆ i64   │
+╞════════╪═════════╪═══════╪═════════╪═══════╡
+│ A      ┆ 10      ┆ 10    ┆ 10      ┆ 10    │
+│ A      ┆ null    ┆ 10    ┆ 10      ┆ null  │
+│ A      ┆ null    ┆ 10    ┆ null    ┆ null  │
+│ B      ┆ null    ┆ null  ┆ null    ┆ 5     │
+│ B      ┆ 5       ┆ 5     ┆ 5       ┆ 5     │
+│ B      ┆ null    ┆ 5     ┆ 5       ┆ null  │
+└────────┴─────────┴───────┴─────────┴───────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("InterpolateOverPartition", func(t *testing.T) {
+		readings := []byte("sensor,reading\n" +
+			"A,\n" +
+			"A,1\n" +
+			"A,\n" +
+			"A,3\n" +
+			"A,\n" +
+			"B,10\n" +
+			"B,\n" +
+			"B,\n" +
+			"B,16\n")
+		result, err := ReadCSVBytes(readings, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("sensor"),
+			Col("reading"),
+			Col("reading").Interpolate().Over("sensor").Alias("linear"),
+			Col("reading").Interpolate().Alias("across"), // Bridges A's trailing null towards B
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: gaps are filled linearly; nulls without values on both sides stay null
+		expected := `shape: (9, 4)
+┌────────┬─────────┬────────┬────────┐
+│ sensor ┆ reading ┆ linear ┆ across │
+│ ---    ┆ ---     ┆ ---    ┆ ---    │
+│ str    ┆ i64     ┆ f64    ┆ f64    │
+╞════════╪═════════╪════════╪════════╡
+│ A      ┆ null    ┆ null   ┆ null   │
+│ A      ┆ 1       ┆ 1.0    ┆ 1.0    │
+│ A      ┆ null    ┆ 2.0    ┆ 2.0    │
+│ A      ┆ 3       ┆ 3.0    ┆ 3.0    │
+│ A      ┆ null    ┆ null   ┆ 6.5    │
+│ B      ┆ 10      ┆ 10.0   ┆ 10.0   │
+│ B      ┆ null    ┆ 12.0   ┆ 12.0   │
+│ B      ┆ null    ┆ 14.0   ┆ 14.0   │
+│ B      ┆ 16      ┆ 16.0   ┆ 16.0   │
+└────────┴─────────┴────────┴────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("Coalesce", func(t *testing.T) {
+		// Names from two sources, as left by an outer join
+		people := []byte("id,name_left,name_right\n" +
+			"1,Ann,\n" +
+			"2,,Ben\n" +
+			"3,Cy,Cyrus\n" +
+			"4,,\n")
+		result, err := ReadCSVBytes(people, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("id"),
+			Coalesce(Col("name_left"), Col("name_right")).Alias("name"),
+			Coalesce(Col("name_left"), Col("name_right"), Lit("unknown")).Alias("display"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the left name wins when both are present
+		expected := `shape: (4, 3)
+┌─────┬──────┬─────────┐
+│ id  ┆ name ┆ display │
+│ --- ┆ ---  ┆ ---     │
+│ i64 ┆ str  ┆ str     │
+╞═════╪══════╪═════════╡
+│ 1   ┆ Ann  ┆ Ann     │
+│ 2   ┆ Ben  ┆ Ben     │
+│ 3   ┆ Cy   ┆ Cy      │
+│ 4   ┆ null ┆ unknown │
+└─────┴──────┴─────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").SelectExpr(Coalesce().Alias("none")).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Coalesce() requires at least one expression")
+	})
+
+	t.Run("NumericTransforms", func(t *testing.T) {
+		perAge := func() *ExprNode { return Col("salary").Cast(Float64).Div(Col("age")) }
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.SelectExpr(
+			Col("name"),
+			perAge().Round(2).Alias("per_age"),
+			perAge().Floor().Alias("per_age_floor"),
+			perAge().Ceil().Alias("per_age_ceil"),
+			Col("age").Sub(Lit(30)).Abs().Alias("age_gap"),
+			Col("age").Round(0), // No-op on an integer column
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (7, 6)
+┌─────────┬─────────┬───────────────┬──────────────┬─────────┬─────┐
+│ name    ┆ per_age ┆ per_age_floor ┆ per_age_ceil ┆ age_gap ┆ age │
+│ ---     ┆ ---     ┆ ---           ┆ ---          ┆ ---     ┆ --- │
+│ str     ┆ f64     ┆ f64           ┆ f64          ┆ i64     ┆ i64 │
+╞═════════╪═════════╪═══════════════╪══════════════╪═════════╪═════╡
+│ Alice   ┆ 2000.0  ┆ 2000.0        ┆ 2000.0       ┆ 5       ┆ 25  │
+│ Bob     ┆ 2000.0  ┆ 2000.0        ┆ 2000.0       ┆ 0       ┆ 30  │
+│ Charlie ┆ 2000.0  ┆ 2000.0        ┆ 2000.0       ┆ 5       ┆ 35  │
+│ Diana   ┆ 1964.29 ┆ 1964.0        ┆ 1965.0       ┆ 2       ┆ 28  │
+│ Eve     ┆ 2031.25 ┆ 2031.0        ┆ 2032.0       ┆ 2       ┆ 32  │
+│ Frank   ┆ 2000.0  ┆ 2000.0        ┆ 2000.0       ┆ 1       ┆ 29  │
+│ Grace   ┆ 1925.93 ┆ 1925.0        ┆ 1926.0       ┆ 3       ┆ 27  │
+└─────────┴─────────┴───────────────┴──────────────┴─────────┴─────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("MathFunctions", func(t *testing.T) {
+		values := []byte("x,p\n" +
+			"1,1\n" +
+			"4,10\n" +
+			"16,100\n")
+		result, err := ReadCSVBytes(values, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("x"),
+			Col("p"),
+			Col("x").Pow(Lit(2.0)).Alias("x_sq"),
+			Col("x").Sqrt().Alias("x_sqrt"),
+			Col("x").Log(2).Alias("x_log2"),
+			Col("x").Sqrt().Exp().Alias("exp_sqrt_x"),
+			Col("p").Log10().Alias("p_log10"),
+			Col("p").Ln().Alias("p_ln"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: integer inputs produce Float64 results
+		expected := `shape: (3, 8)
+┌─────┬─────┬───────┬────────┬────────┬────────────┬─────────┬──────────┐
+│ x   ┆ p   ┆ x_sq  ┆ x_sqrt ┆ x_log2 ┆ exp_sqrt_x ┆ p_log10 ┆ p_ln     │
+│ --- ┆ --- ┆ ---   ┆ ---    ┆ ---    ┆ ---        ┆ ---     ┆ ---      │
+│ i64 ┆ i64 ┆ f64   ┆ f64    ┆ f64    ┆ f64        ┆ f64     ┆ f64      │
+╞═════╪═════╪═══════╪════════╪════════╪════════════╪═════════╪══════════╡
+│ 1   ┆ 1   ┆ 1.0   ┆ 1.0    ┆ 0.0    ┆ 2.718282   ┆ 0.0     ┆ 0.0      │
+│ 4   ┆ 10  ┆ 16.0  ┆ 2.0    ┆ 2.0    ┆ 7.389056   ┆ 1.0     ┆ 2.302585 │
+│ 16  ┆ 100 ┆ 256.0 ┆ 4.0    ┆ 4.0    ┆ 54.59815   ┆ 2.0     ┆ 4.60517  │
+└─────┴─────┴───────┴────────┴────────┴────────────┴─────────┴──────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("Modulo", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.SelectExpr(
+			Col("name"),
+			Col("age"),
+			Col("age").Mod(Lit(10)).Alias("age_mod_10"),
+			Col("age").Mod(Lit(0)).Alias("age_mod_0"), // Integer modulo by zero is null
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (7, 4)
+┌─────────┬─────┬────────────┬───────────┐
+│ name    ┆ age ┆ age_mod_10 ┆ age_mod_0 │
+│ ---     ┆ --- ┆ ---        ┆ ---       │
+│ str     ┆ i64 ┆ i64        ┆ i64       │
+╞═════════╪═════╪════════════╪═══════════╡
+│ Alice   ┆ 25  ┆ 5          ┆ null      │
+│ Bob     ┆ 30  ┆ 0          ┆ null      │
+│ Charlie ┆ 35  ┆ 5          ┆ null      │
+│ Diana   ┆ 28  ┆ 8          ┆ null      │
+│ Eve     ┆ 32  ┆ 2          ┆ null      │
+│ Frank   ┆ 29  ┆ 9          ┆ null      │
+│ Grace   ┆ 27  ┆ 7          ┆ null      │
+└─────────┴─────┴────────────┴───────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("Clip", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.SelectExpr(
+			Col("name"),
+			Col("age").Clip(Lit(27), Lit(32)).Alias("age_clipped"),
+			Col("age").ClipMin(Lit(30)).Alias("age_floor_30"),
+			Col("salary").Clip(nil, Lit(60000)).Alias("salary_capped"), // Unbounded below
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: integer columns keep their i64 type
+		expected := `shape: (7, 4)
+┌─────────┬─────────────┬──────────────┬───────────────┐
+│ name    ┆ age_clipped ┆ age_floor_30 ┆ salary_capped │
+│ ---     ┆ ---         ┆ ---          ┆ ---           │
+│ str     ┆ i64         ┆ i64          ┆ i64           │
+╞═════════╪═════════════╪══════════════╪═══════════════╡
+│ Alice   ┆ 27          ┆ 30           ┆ 50000         │
+│ Bob     ┆ 30          ┆ 30           ┆ 60000         │
+│ Charlie ┆ 32          ┆ 35           ┆ 60000         │
+│ Diana   ┆ 28          ┆ 30           ┆ 55000         │
+│ Eve     ┆ 32          ┆ 32           ┆ 60000         │
+│ Frank   ┆ 29          ┆ 30           ┆ 58000         │
+│ Grace   ┆ 27          ┆ 30           ┆ 52000         │
+└─────────┴─────────────┴──────────────┴───────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("StrStrip", func(t *testing.T) {
+		messy := []byte("department,label,code,file\n" +
+			"\"  Engineering \",**gold**,ID-001,sales.csv\n" +
+			"Sales,-silver-,ID-002,report.csv.bak\n" +
+			"\" Marketing\",bronze,X-003,notes\n")
+		result, err := ReadCSVBytes(messy, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("department").StrStripChars("").StrToLowercase(), // Whitespace
+			Col("label").StrStripChars("*-"),
+			Col("code").StrStripPrefix("ID-").Alias("number"),
+			Col("file").StrStripSuffix(".csv"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: values without the prefix/suffix are left unchanged
+		expected := `shape: (3, 4)
+┌─────────────┬────────┬────────┬────────────────┐
+│ department  ┆ label  ┆ number ┆ file           │
+│ ---         ┆ ---    ┆ ---    ┆ ---            │
+│ str         ┆ str    ┆ str    ┆ str            │
+╞═════════════╪════════╪════════╪════════════════╡
+│ engineering ┆ gold   ┆ 001    ┆ sales          │
+│ sales       ┆ silver ┆ 002    ┆ report.csv.bak │
+│ marketing   ┆ bronze ┆ X-003  ┆ notes          │
+└─────────────┴────────┴────────┴────────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ConcatStr", func(t *testing.T) {
+		desks := []byte("dept,region,floor\n" +
+			"Eng,EU,3\n" +
+			"Ops,,5\n")
+		result, err := ReadCSVBytes(desks, CSVReadConfig{HasHeader: true}).SelectExpr(
+			ConcatStr("-", Col("dept"), Col("region")).Alias("key"),
+			ConcatStrIgnoreNulls("-", Col("dept"), Col("region")).Alias("key_partial"),
+			ConcatStr("/", Col("dept"), Col("floor")).Alias("desk"), // i64 floor is cast to str
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: a null region nulls the key unless nulls are ignored
+		expected := `shape: (2, 3)
+┌────────┬─────────────┬───────┐
+│ key    ┆ key_partial ┆ desk  │
+│ ---    ┆ ---         ┆ ---   │
+│ str    ┆ str         ┆ str   │
+╞════════╪═════════════╪═══════╡
+│ Eng-EU ┆ Eng-EU      ┆ Eng/3 │
+│ null   ┆ Ops         ┆ Ops/5 │
+└────────┴─────────────┴───────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ListAccessors", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			GroupBy("department").
+			Agg(Col("name").Alias("names")). // No aggregation: one list of names per group
+			Sort([]string{"department"}).
+			SelectExpr(
+				Col("department"),
+				Col("names").ListLen().Alias("n"),
+				Col("names").ListFirst().Alias("first"),
+				Col("names").ListGet(2).Alias("third"), // Out of bounds for two-name lists
+				Col("names").ListLast().Alias("last"),
+				Col("names").ListContains("Eve").Alias("has_eve"),
+			).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (3, 6)
+┌─────────────┬─────┬───────┬───────┬───────┬─────────┐
+│ department  ┆ n   ┆ first ┆ third ┆ last  ┆ has_eve │
+│ ---         ┆ --- ┆ ---   ┆ ---   ┆ ---   ┆ ---     │
+│ str         ┆ u32 ┆ str   ┆ str   ┆ str   ┆ bool    │
+╞═════════════╪═════╪═══════╪═══════╪═══════╪═════════╡
+│ Engineering ┆ 3   ┆ Alice ┆ Eve   ┆ Eve   ┆ true    │
+│ Marketing   ┆ 2   ┆ Bob   ┆ null  ┆ Frank ┆ false   │
+│ Sales       ┆ 2   ┆ Diana ┆ null  ┆ Grace ┆ false   │
+└─────────────┴─────┴───────┴───────┴───────┴─────────┘`
+		require.Equal(t, expected, result.String())
+
+		// Filter on list membership, and cast a list column's elements
+		result, err = ReadCSV("../testdata/sample.csv").
+			GroupBy("department").
+			Agg(Col("name").Alias("names"), Col("age").Alias("ages")).
+			Filter(Col("names").ListContains("Frank")).
+			SelectExpr(Col("department"), Col("ages").Cast(List(Float64)).ListFirst().Alias("first_age")).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (1, 2)
+┌────────────┬───────────┐
+│ department ┆ first_age │
+│ ---        ┆ ---       │
+│ str        ┆ f64       │
+╞════════════╪═══════════╡
+│ Marketing  ┆ 30.0      │
+└────────────┴───────────┘`
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("StrExtract", func(t *testing.T) {
+		logs := []byte("log\n" +
+			"2024-01-15 ERROR disk full\n" +
+			"no date here\n" +
+			"2023-12-31 INFO rotated\n")
+		result, err := ReadCSVBytes(logs, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("log").StrExtract(`\d{4}-\d{2}-\d{2}`, 0).Alias("date"), // Whole match
+			Col("log").StrExtract(`(\d{4})-\d{2}`, 1).Alias("year"),
+			Col("log").StrExtract(`\d (ERROR|WARN|INFO) `, 1).Alias("level"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: rows without a match produce nulls
+		expected := `shape: (3, 3)
+┌────────────┬──────┬───────┐
+│ date       ┆ year ┆ level │
+│ ---        ┆ ---  ┆ ---   │
+│ str        ┆ str  ┆ str   │
+╞════════════╪══════╪═══════╡
+│ 2024-01-15 ┆ 2024 ┆ ERROR │
+│ null       ┆ null ┆ null  │
+│ 2023-12-31 ┆ 2023 ┆ INFO  │
+└────────────┴──────┴───────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("StrExtractInvalidRegex", func(t *testing.T) {
+		_, err := ReadCSV("../testdata/sample.csv").
+			SelectExpr(Col("name").StrExtract(`([a-z`, 1)).
+			Collect()
+		require.Error(t, err)
+
+		var polarsErr *Error
+		require.ErrorAs(t, err, &polarsErr)
+		require.Contains(t, err.Error(), "regex")
+	})
+
+	t.Run("DateParts", func(t *testing.T) {
+		events := []byte("ts\n" +
+			"2024-03-15T08:30:45\n" +
+			"2023-12-31T23:59:07\n")
+		ts := func() *ExprNode { return Col("ts").Cast(DatetimeMicros) }
+		result, err := ReadCSVBytes(events, CSVReadConfig{HasHeader: true}).SelectExpr(
+			ts().Year().Alias("year"),
+			ts().Month().Alias("month"),
+			ts().Day().Alias("day"),
+			ts().Hour().Alias("hour"),
+			ts().Minute().Alias("minute"),
+			ts().Second().Alias("second"),
+			ts().Weekday().Alias("weekday"), // Friday and Sunday
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (2, 7)
+┌──────┬───────┬─────┬──────┬────────┬────────┬─────────┐
+│ year ┆ month ┆ day ┆ hour ┆ minute ┆ second ┆ weekday │
+│ ---  ┆ ---   ┆ --- ┆ ---  ┆ ---    ┆ ---    ┆ ---     │
+│ i32  ┆ i8    ┆ i8  ┆ i8   ┆ i8     ┆ i8     ┆ i8      │
+╞══════╪═══════╪═════╪══════╪════════╪════════╪═════════╡
+│ 2024 ┆ 3     ┆ 15  ┆ 8    ┆ 30     ┆ 45     ┆ 5       │
+│ 2023 ┆ 12    ┆ 31  ┆ 23   ┆ 59     ┆ 7      ┆ 7       │
+└──────┴───────┴─────┴──────┴────────┴────────┴─────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("DatePartOnString", func(t *testing.T) {
+		_, err := ReadCSV("../testdata/sample.csv").SelectExpr(Col("name").Year()).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "year")
+	})
+
+	t.Run("StrToDateAndDatetime", func(t *testing.T) {
+		raw := []byte("day,stamp\n" +
+			"2024-03-15,15/03/2024 08:30\n" +
+			"not a date,31/12/2023 23:59\n")
+		result, err := ReadCSVBytes(raw, CSVReadConfig{HasHeader: true}).SelectExpr(
+			Col("day").StrToDate("%Y-%m-%d"),
+			Col("stamp").StrToDatetime("%d/%m/%Y %H:%M", DatetimeMillis),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the unparseable day becomes null by default
+		expected := `shape: (2, 2)
+┌────────────┬─────────────────────┐
+│ day        ┆ stamp               │
+│ ---        ┆ ---                 │
+│ date       ┆ datetime[ms]        │
+╞════════════╪═════════════════════╡
+│ 2024-03-15 ┆ 2024-03-15 08:30:00 │
+│ null       ┆ 2023-12-31 23:59:00 │
+└────────────┴─────────────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("StrToDateStrict", func(t *testing.T) {
+		raw := []byte("day\n" +
+			"2024-03-15\n" +
+			"not a date\n")
+		_, err := ReadCSVBytes(raw, CSVReadConfig{HasHeader: true}).
+			SelectExpr(Col("day").StrToDateStrict("%Y-%m-%d", true)).
+			Collect()
+		require.Error(t, err)
+	})
+
+	t.Run("DtTruncateHourlyBuckets", func(t *testing.T) {
+		events := []byte("ts,value\n" +
+			"2024-03-15T08:05:00,1\n" +
+			"2024-03-15T08:55:00,2\n" +
+			"2024-03-15T09:10:00,3\n")
+		result, err := ReadCSVBytes(events, CSVReadConfig{HasHeader: true}).
+			GroupBy(Col("ts").Cast(DatetimeMicros).DtTruncate("1h").Alias("hour")).
+			Agg(Col("value").Sum().Alias("total")).
+			Sort([]string{"hour"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: buckets stay Datetime
+		expected := `shape: (2, 2)
+┌─────────────────────┬───────┐
+│ hour                ┆ total │
+│ ---                 ┆ ---   │
+│ datetime[μs]        ┆ i64   │
+╞═════════════════════╪═══════╡
+│ 2024-03-15 08:00:00 ┆ 3     │
+│ 2024-03-15 09:00:00 ┆ 3     │
+└─────────────────────┴───────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("DtTruncateInvalidDuration", func(t *testing.T) {
+		_, err := ReadCSV("../testdata/sample.csv").SelectExpr(Col("name").DtTruncate("hourly")).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `DtTruncate() invalid duration "hourly"`)
+	})
+
+	t.Run("CumulativeAggregations", func(t *testing.T) {
+		sales := []byte("region,sales\n" +
+			"East,10\n" +
+			"West,5\n" +
+			"East,20\n" +
+			"West,15\n" +
+			"East,30\n")
+		result, err := ReadCSVBytes(sales, CSVReadConfig{HasHeader: true}).WithColumns(
+			Col("sales").Cumsum(false).Over("region").Alias("running_total"),
+			Col("sales").Cumprod(false).Over("region").Alias("running_product"),
+			Col("sales").Cummax(false).Alias("running_max"),
+			Col("sales").Cumsum(true).Alias("remaining"), // Reverse: sum from this row to the end
+			Col("sales").Cummin(true).Alias("min_to_end"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: Over() restarts the running values for each region
+		expected := `shape: (5, 7)
+┌────────┬───────┬───────────────┬─────────────────┬─────────────┬───────────┬────────────┐
+│ region ┆ sales ┆ running_total ┆ running_product ┆ running_max ┆ remaining ┆ min_to_end │
+│ ---    ┆ ---   ┆ ---           ┆ ---             ┆ ---         ┆ ---       ┆ ---        │
+│ str    ┆ i64   ┆ i64           ┆ i64             ┆ i64         ┆ i64       ┆ i64        │
+╞════════╪═══════╪═══════════════╪═════════════════╪═════════════╪═══════════╪════════════╡
+│ East   ┆ 10    ┆ 10            ┆ 10              ┆ 10          ┆ 80        ┆ 5          │
+│ West   ┆ 5     ┆ 5             ┆ 5               ┆ 10          ┆ 70        ┆ 5          │
+│ East   ┆ 20    ┆ 30            ┆ 200             ┆ 20          ┆ 65        ┆ 15         │
+│ West   ┆ 15    ┆ 20            ┆ 75              ┆ 20          ┆ 45        ┆ 15         │
+│ East   ┆ 30    ┆ 60            ┆ 6000            ┆ 30          ┆ 30        ┆ 30         │
+└────────┴───────┴───────────────┴─────────────────┴─────────────┴───────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ShiftAndDiff", func(t *testing.T) {
+		prices := []byte("day,price\n1,100\n2,103\n3,101\n4,110\n")
+		result, err := ReadCSVBytes(prices, CSVReadConfig{HasHeader: true}).WithColumns(
+			Col("price").Shift(1).Alias("prev_price"),
+			Col("price").Shift(-1).Alias("next_price"),
+			Col("price").Diff(1).Alias("delta"),
+			Col("price").Diff(2).Alias("delta_2"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the vacated boundary rows are null
+		expected := `shape: (4, 6)
+┌─────┬───────┬────────────┬────────────┬───────┬─────────┐
+│ day ┆ price ┆ prev_price ┆ next_price ┆ delta ┆ delta_2 │
+│ --- ┆ ---   ┆ ---        ┆ ---        ┆ ---   ┆ ---     │
+│ i64 ┆ i64   ┆ i64        ┆ i64        ┆ i64   ┆ i64     │
+╞═════╪═══════╪════════════╪════════════╪═══════╪═════════╡
+│ 1   ┆ 100   ┆ null       ┆ 103        ┆ null  ┆ null    │
+│ 2   ┆ 103   ┆ 100        ┆ 101        ┆ 3     ┆ null    │
+│ 3   ┆ 101   ┆ 103        ┆ 110        ┆ -2    ┆ 1       │
+│ 4   ┆ 110   ┆ 101        ┆ null       ┆ 9     ┆ 7       │
+└─────┴───────┴────────────┴────────────┴───────┴─────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("PctChangeOverPartition", func(t *testing.T) {
+		closes := []byte("ticker,close\nA,100\nB,0\nA,110\nB,5\nA,99\nB,10\n")
+		result, err := ReadCSVBytes(closes, CSVReadConfig{HasHeader: true}).WithColumns(
+			Col("close").PctChange(1).Over("ticker").Alias("return"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: each ticker starts with null, and B's zero close yields inf
+		expected := `shape: (6, 3)
+┌────────┬───────┬────────┐
+│ ticker ┆ close ┆ return │
+│ ---    ┆ ---   ┆ ---    │
+│ str    ┆ i64   ┆ f64    │
+╞════════╪═══════╪════════╡
+│ A      ┆ 100   ┆ null   │
+│ B      ┆ 0     ┆ null   │
+│ A      ┆ 110   ┆ 0.1    │
+│ B      ┆ 5     ┆ inf    │
+│ A      ┆ 99    ┆ -0.1   │
+│ B      ┆ 10    ┆ 1.0    │
+└────────┴───────┴────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("FloatPredicates", func(t *testing.T) {
+		data := []byte("num,den\n1,0\n0,0\n4,2\n-1,0\n")
+		ratios := func() *DataFrame {
+			return ReadCSVBytes(data, CSVReadConfig{HasHeader: true}).WithColumns(
+				Col("num").Cast(Float64).Div(Col("den")).Alias("ratio"),
+			)
+		}
+
+		result, err := ratios().WithColumns(
+			Col("ratio").IsNaN().Alias("nan"),
+			Col("ratio").IsNotNaN().Alias("not_nan"),
+			Col("ratio").IsFinite().Alias("finite"),
+			Col("ratio").IsInfinite().Alias("infinite"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: 0/0 is NaN, x/0 is +/-inf, and neither is null
+		expected := `shape: (4, 7)
+┌─────┬─────┬───────┬───────┬─────────┬────────┬──────────┐
+│ num ┆ den ┆ ratio ┆ nan   ┆ not_nan ┆ finite ┆ infinite │
+│ --- ┆ --- ┆ ---   ┆ ---   ┆ ---     ┆ ---    ┆ ---      │
+│ i64 ┆ i64 ┆ f64   ┆ bool  ┆ bool    ┆ bool   ┆ bool     │
+╞═════╪═════╪═══════╪═══════╪═════════╪════════╪══════════╡
+│ 1   ┆ 0   ┆ inf   ┆ false ┆ true    ┆ false  ┆ true     │
+│ 0   ┆ 0   ┆ NaN   ┆ true  ┆ false   ┆ false  ┆ false    │
+│ 4   ┆ 2   ┆ 2.0   ┆ false ┆ true    ┆ true   ┆ false    │
+│ -1  ┆ 0   ┆ -inf  ┆ false ┆ true    ┆ false  ┆ true     │
+└─────┴─────┴───────┴───────┴─────────┴────────┴──────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		finite, err := ratios().Filter(Col("ratio").IsFinite()).Collect()
+		require.NoError(t, err)
+		defer finite.Release()
+
+		expected = `shape: (1, 3)
+┌─────┬─────┬───────┐
+│ num ┆ den ┆ ratio │
+│ --- ┆ --- ┆ ---   │
+│ i64 ┆ i64 ┆ f64   │
+╞═════╪═════╪═══════╡
+│ 4   ┆ 2   ┆ 2.0   │
+└─────┴─────┴───────┘`
+
+		require.Equal(t, expected, finite.String())
+	})
+
+	t.Run("UniquenessPredicates", func(t *testing.T) {
+		data := []byte("team,email\nred,a@x.io\nred,b@x.io\nblue,a@x.io\nblue,c@x.io\nred,b@x.io\n")
+		result, err := ReadCSVBytes(data, CSVReadConfig{HasHeader: true}).WithColumns(
+			Col("email").IsUnique().Alias("unique"),
+			Col("email").IsDuplicated().Alias("duplicated"),
+			Col("email").IsUnique().Over("team").Alias("unique_in_team"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: a@x.io repeats across teams but is unique within each team
+		expected := `shape: (5, 5)
+┌──────┬────────┬────────┬────────────┬────────────────┐
+│ team ┆ email  ┆ unique ┆ duplicated ┆ unique_in_team │
+│ ---  ┆ ---    ┆ ---    ┆ ---        ┆ ---            │
+│ str  ┆ str    ┆ bool   ┆ bool       ┆ bool           │
+╞══════╪════════╪════════╪════════════╪════════════════╡
+│ red  ┆ a@x.io ┆ false  ┆ true       ┆ true           │
+│ red  ┆ b@x.io ┆ false  ┆ true       ┆ false          │
+│ blue ┆ a@x.io ┆ false  ┆ true       ┆ true           │
+│ blue ┆ c@x.io ┆ true   ┆ false      ┆ true           │
+│ red  ┆ b@x.io ┆ false  ┆ true       ┆ false          │
+└──────┴────────┴────────┴────────────┴────────────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		unique, err := ReadCSVBytes(data, CSVReadConfig{HasHeader: true}).Filter(Col("email").IsUnique()).Collect()
+		require.NoError(t, err)
+		defer unique.Release()
+
+		expected = `shape: (1, 2)
+┌──────┬────────┐
+│ team ┆ email  │
+│ ---  ┆ ---    │
+│ str  ┆ str    │
+╞══════╪════════╡
+│ blue ┆ c@x.io │
+└──────┴────────┘`
+
+		require.Equal(t, expected, unique.String())
+	})
+
+	t.Run("WithColumns", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.WithColumns(
+			Col("salary").Mul(Lit(2)).Alias("double_salary"),
+			Col("age").Add(Lit(10)).Alias("age_plus_10"),
+		).Select("name", "double_salary", "age_plus_10").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: computed columns
+		expected := `shape: (7, 3)
+┌─────────┬───────────────┬─────────────┐
+│ name    ┆ double_salary ┆ age_plus_10 │
+│ ---     ┆ ---           ┆ ---         │
+│ str     ┆ i64           ┆ i64         │
+╞═════════╪═══════════════╪═════════════╡
+│ Alice   ┆ 100000        ┆ 35          │
+│ Bob     ┆ 120000        ┆ 40          │
+│ Charlie ┆ 140000        ┆ 45          │
+│ Diana   ┆ 110000        ┆ 38          │
+│ Eve     ┆ 130000        ┆ 42          │
+│ Frank   ┆ 116000        ┆ 39          │
+│ Grace   ┆ 104000        ┆ 37          │
+└─────────┴───────────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("WithConstant", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.WithConstant("source", "sample").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the literal is broadcast to all 7 rows
+		expected := `shape: (7, 5)
+┌─────────┬─────┬────────┬─────────────┬────────┐
+│ name    ┆ age ┆ salary ┆ department  ┆ source │
+│ ---     ┆ --- ┆ ---    ┆ ---         ┆ ---    │
+│ str     ┆ i64 ┆ i64    ┆ str         ┆ str    │
+╞═════════╪═════╪════════╪═════════════╪════════╡
+│ Alice   ┆ 25  ┆ 50000  ┆ Engineering ┆ sample │
+│ Bob     ┆ 30  ┆ 60000  ┆ Marketing   ┆ sample │
+│ Charlie ┆ 35  ┆ 70000  ┆ Engineering ┆ sample │
+│ Diana   ┆ 28  ┆ 55000  ┆ Sales       ┆ sample │
+│ Eve     ┆ 32  ┆ 65000  ┆ Engineering ┆ sample │
+│ Frank   ┆ 29  ┆ 58000  ┆ Marketing   ┆ sample │
+│ Grace   ┆ 27  ┆ 52000  ┆ Sales       ┆ sample │
+└─────────┴─────┴────────┴─────────────┴────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").WithConstant("bad", []int{1}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported literal type []int")
+	})
+
+	t.Run("StringOperations", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.SelectExpr(
+			Col("name").Alias("name"),
+			Col("name").StrLen().Alias("name_length"),
+			Col("name").StrToUppercase().Alias("name_upper"),
+			Col("name").StrContains("a").Alias("contains_a"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: string operations
+		expected := `shape: (7, 4)
+┌─────────┬─────────────┬────────────┬────────────┐
+│ name    ┆ name_length ┆ name_upper ┆ contains_a │
+│ ---     ┆ ---         ┆ ---        ┆ ---        │
+│ str     ┆ u32         ┆ str        ┆ bool       │
+╞═════════╪═════════════╪════════════╪════════════╡
+│ Alice   ┆ 5           ┆ ALICE      ┆ false      │
+│ Bob     ┆ 3           ┆ BOB        ┆ false      │
+│ Charlie ┆ 7           ┆ CHARLIE    ┆ true       │
+│ Diana   ┆ 5           ┆ DIANA      ┆ true       │
+│ Eve     ┆ 3           ┆ EVE        ┆ false      │
+│ Frank   ┆ 5           ┆ FRANK      ┆ true       │
+│ Grace   ┆ 5           ┆ GRACE      ┆ true       │
+└─────────┴─────────────┴────────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("HashDeterminism", func(t *testing.T) {
+		hashNames := func(seed uint64) string {
+			result, err := ReadCSV("../testdata/sample.csv").SelectExpr(
+				Col("name").Hash(seed).Alias("name_hash"),
+			).Collect()
+			require.NoError(t, err)
+			defer result.Release()
+
+			csv, err := result.ToCsv()
+			require.NoError(t, err)
+			return csv
+		}
+
+		// Same seed gives identical hashes; a different seed changes them
+		first := hashNames(42)
+		require.Equal(t, first, hashNames(42))
+		require.NotEqual(t, first, hashNames(7))
+
+		result, err := ReadCSV("../testdata/sample.csv").SelectExpr(
+			Col("name").Hash(42).Alias("name_hash"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+		require.Contains(t, result.String(), "u64")
+	})
+
+	t.Run("ColumnPatterns", func(t *testing.T) {
+		readings := func() *DataFrame {
+			df, err := FromColumns(map[string][]any{
+				"station":         {"north", "south", "east"},
+				"sensor_temp":     {20.0, 22.0, 24.0},
+				"sensor_humidity": {40, 50, 60},
+				"battery":         {90, nil, 70},
+			})
+			require.NoError(t, err)
+			return df
+		}
+
+		// Regex: the trailing $ is added for Polars
+		result, err := readings().SelectExpr(Cols("^sensor_").Mean()).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (1, 2)
+┌─────────────────┬─────────────┐
+│ sensor_humidity ┆ sensor_temp │
+│ ---             ┆ ---         │
+│ f64             ┆ f64         │
+╞═════════════════╪═════════════╡
+│ 50.0            ┆ 22.0        │
+└─────────────────┴─────────────┘`
+		require.Equal(t, expected, result.String())
+
+		// Wildcard expands to the same columns, each keeping its dtype
+		result, err = readings().SelectExpr(Cols("sensor_*").Max()).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (1, 2)
+┌─────────────────┬─────────────┐
+│ sensor_humidity ┆ sensor_temp │
+│ ---             ┆ ---         │
+│ i64             ┆ f64         │
+╞═════════════════╪═════════════╡
+│ 60              ┆ 24.0        │
+└─────────────────┴─────────────┘`
+		require.Equal(t, expected, result.String())
+
+		result, err = readings().SelectExpr(AllCols().Count()).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (1, 4)
+┌─────────┬─────────────────┬─────────────┬─────────┐
+│ battery ┆ sensor_humidity ┆ sensor_temp ┆ station │
+│ ---     ┆ ---             ┆ ---         ┆ ---     │
+│ u32     ┆ u32             ┆ u32         ┆ u32     │
+╞═════════╪═════════════════╪═════════════╪═════════╡
+│ 2       ┆ 3               ┆ 3           ┆ 3       │
+└─────────┴─────────────────┴─────────────┴─────────┘`
+		require.Equal(t, expected, result.String())
+
+		_, err = readings().SelectExpr(Cols("^sensor_(")).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `Cols() invalid regex "^sensor_("`)
+	})
+
+	t.Run("ExcludeColumns", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			SelectExpr(AllCols().Exclude("salary", "department")).
+			Limit(2).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (2, 2)
+┌───────┬─────┐
+│ name  ┆ age │
+│ ---   ┆ --- │
+│ str   ┆ i64 │
+╞═══════╪═════╡
+│ Alice ┆ 25  │
+│ Bob   ┆ 30  │
+└───────┴─────┘`
+		require.Equal(t, expected, result.String())
+
+		// Exclusion applies before the aggregation over the remaining columns
+		result, err = ReadCSV("../testdata/sample.csv").
+			SelectExpr(Cols("^(age|salary)$").Exclude("age").Max()).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (1, 1)
+┌────────┐
+│ salary │
+│ ---    │
+│ i64    │
+╞════════╡
+│ 70000  │
+└────────┘`
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").SelectExpr(AllCols().Exclude()).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Exclude() requires at least one column")
+	})
+
+	t.Run("NameTemplates", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			SelectExpr(
+				Cols("^(age|salary)$").Max().NamePrefix("max_"),
+				Cols("^(age|salary)$").Min().NameSuffix("_min"),
+			).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (1, 4)
+┌─────────┬────────────┬─────────┬────────────┐
+│ max_age ┆ max_salary ┆ age_min ┆ salary_min │
+│ ---     ┆ ---        ┆ ---     ┆ ---        │
+│ i64     ┆ i64        ┆ i64     ┆ i64        │
+╞═════════╪════════════╪═════════╪════════════╡
+│ 35      ┆ 70000      ┆ 25      ┆ 50000      │
+└─────────┴────────────┴─────────┴────────────┘`
+		require.Equal(t, expected, result.String())
+
+		// KeepName restores the root column name after an Alias
+		result, err = ReadCSV("../testdata/sample.csv").
+			SelectExpr(Col("salary").Div(Lit(1000.0)).Alias("salary_k").KeepName()).
+			Limit(2).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (2, 1)
+┌────────┐
+│ salary │
+│ ---    │
+│ f64    │
+╞════════╡
+│ 50.0   │
+│ 60.0   │
+└────────┘`
+		require.Equal(t, expected, result.String())
+	})
+}
+
+// TestAggregations demonstrates GroupBy and aggregation operations
+func TestAggregations(t *testing.T) {
+	t.Run("BasicAggregations", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.SelectExpr(
+			Col("salary").Sum().Alias("total_salary"),
+			Col("age").Mean().Alias("avg_age"),
+			Col("salary").Min().Alias("min_salary"),
+			Col("salary").Max().Alias("max_salary"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: basic aggregations
+		expected := `shape: (1, 4)
+┌──────────────┬───────────┬────────────┬────────────┐
+│ total_salary ┆ avg_age   ┆ min_salary ┆ max_salary │
+│ ---          ┆ ---       ┆ ---        ┆ ---        │
+│ i64          ┆ f64       ┆ i64        ┆ i64        │
+╞══════════════╪═══════════╪════════════╪════════════╡
+│ 410000       ┆ 29.428571 ┆ 50000      ┆ 70000      │
+└──────────────┴───────────┴────────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("DotProduct", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.SelectExpr(
+			DotProduct(Col("salary"), Col("age")).Alias("weighted_score"),
+			Col("salary").Mul(Col("age")).Sum().Alias("manual_score"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: sum(salary * age) computed both ways
+		expected := `shape: (1, 2)
+┌────────────────┬──────────────┐
+│ weighted_score ┆ manual_score │
+│ ---            ┆ ---          │
+│ i64            ┆ i64          │
+╞════════════════╪══════════════╡
+│ 12206000       ┆ 12206000     │
+└────────────────┴──────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("WeightedMeanVWAP", func(t *testing.T) {
+		trades := []byte("ticker,price,volume\n" +
+			"AAA,10,100\n" +
+			"AAA,12,300\n" +
+			"BBB,50,0\n" +
+			"BBB,55,0\n" +
+			"CCC,20,1\n")
+		result, err := ReadCSVBytes(trades, CSVReadConfig{HasHeader: true}).
+			GroupBy("ticker").
+			Agg(Col("price").WeightedMean(Col("volume")).Alias("vwap")).
+			Sort([]string{"ticker"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: AAA = (10*100 + 12*300) / 400 = 11.5; BBB has zero total volume
+		expected := `shape: (3, 2)
+┌────────┬──────┐
+│ ticker ┆ vwap │
+│ ---    ┆ ---  │
+│ str    ┆ f64  │
+╞════════╪══════╡
+│ AAA    ┆ 11.5 │
+│ BBB    ┆ null │
+│ CCC    ┆ 20.0 │
+└────────┴──────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ClipQuantileOutliers", func(t *testing.T) {
+		// 1..99 plus one outlier at each end: the 1st/99th percentiles are exactly 1 and 99
+		var data strings.Builder
+		data.WriteString("x\n-500\n")
+		for i := 1; i <= 99; i++ {
+			data.WriteString(strconv.Itoa(i) + "\n")
+		}
+		data.WriteString("500\n")
+
+		result, err := ReadCSVBytes([]byte(data.String()), CSVReadConfig{HasHeader: true}).
+			SelectExpr(
+				Col("x").ClipQuantile(0.01, 0.99).Min().Alias("min"),
+				Col("x").ClipQuantile(0.01, 0.99).Max().Alias("max"),
+				Col("x").ClipQuantile(0.01, 0.99).Sum().Alias("sum"),
+				Col("x").Quantile(0.01, QuantileLinear).Alias("p01"),
+				Col("x").Quantile(0.99, QuantileLinear).Alias("p99"),
+			).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the outliers are pinned to p01/p99, so the sum is 1 + (1..99) + 99
+		expected := `shape: (1, 5)
+┌─────┬──────┬────────┬─────┬──────┐
+│ min ┆ max  ┆ sum    ┆ p01 ┆ p99  │
+│ --- ┆ ---  ┆ ---    ┆ --- ┆ ---  │
+│ f64 ┆ f64  ┆ f64    ┆ f64 ┆ f64  │
+╞═════╪══════╪════════╪═════╪══════╡
+│ 1.0 ┆ 99.0 ┆ 5050.0 ┆ 1.0 ┆ 99.0 │
+└─────┴──────┴────────┴─────┴──────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("EvaluateNamedMetrics", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.Evaluate(map[string]*ExprNode{
+			"total_salary": Col("salary").Sum(),
+			"avg_salary":   Col("salary").Mean(),
+			"max_age":      Col("age").Max(),
+		})
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: columns are named after the map keys, in sorted key order
+		expected := `shape: (1, 3)
+┌──────────────┬─────────┬──────────────┐
+│ avg_salary   ┆ max_age ┆ total_salary │
+│ ---          ┆ ---     ┆ ---          │
+│ f64          ┆ i64     ┆ i64          │
+╞══════════════╪═════════╪══════════════╡
+│ 58571.428571 ┆ 35      ┆ 410000       │
+└──────────────┴─────────┴──────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("EvaluateRequiresExpressions", func(t *testing.T) {
+		_, err := ReadCSV("../testdata/sample.csv").Evaluate(nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "requires at least one expression")
+	})
+
+	t.Run("CorrMatrix", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.CorrMatrix(CorrPearson)
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: string columns are skipped, age and salary are strongly correlated
+		expected := `shape: (2, 3)
+┌────────┬──────────┬──────────┐
+│ column ┆ age      ┆ salary   │
+│ ---    ┆ ---      ┆ ---      │
+│ str    ┆ f64      ┆ f64      │
+╞════════╪══════════╪══════════╡
+│ age    ┆ 1.0      ┆ 0.993004 │
+│ salary ┆ 0.993004 ┆ 1.0      │
+└────────┴──────────┴──────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("GroupByAggregation", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.GroupBy("department").
+			Agg(Col("salary").Mean().Alias("avg_salary")).
+			Sort([]string{"avg_salary"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: group by with aggregation
+		expected := `shape: (3, 2)
+┌─────────────┬──────────────┐
+│ department  ┆ avg_salary   │
+│ ---         ┆ ---          │
+│ str         ┆ f64          │
+╞═════════════╪══════════════╡
+│ Sales       ┆ 53500.0      │
+│ Marketing   ┆ 59000.0      │
+│ Engineering ┆ 61666.666667 │
+└─────────────┴──────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("MultipleQuantiles", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.GroupBy("department").
+			Agg(Col("salary").Quantiles([]float64{0.5, 0.9, 0.99}, QuantileLinear)).
+			Unnest("salary_quantiles").
+			Sort([]string{"department"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: linear interpolation, e.g. Engineering p90 = 65000 + 0.8*(70000-65000)
+		expected := `shape: (3, 4)
+┌─────────────┬────────────┬────────────┬────────────┐
+│ department  ┆ salary_p50 ┆ salary_p90 ┆ salary_p99 │
+│ ---         ┆ ---        ┆ ---        ┆ ---        │
+│ str         ┆ f64        ┆ f64        ┆ f64        │
+╞═════════════╪════════════╪════════════╪════════════╡
+│ Engineering ┆ 65000.0    ┆ 69000.0    ┆ 69900.0    │
+│ Marketing   ┆ 59000.0    ┆ 59800.0    ┆ 59980.0    │
+│ Sales       ┆ 53500.0    ┆ 54700.0    ┆ 54970.0    │
+└─────────────┴────────────┴────────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		// A follow-on op applies to the whole struct, not just the last quantile
+		result, err = ReadCSV("../testdata/sample.csv").
+			SelectExpr(Col("salary").Quantiles([]float64{0.5, 0.9}, QuantileLinear).Alias("q")).
+			Unnest("q").
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (1, 2)
+┌────────────┬────────────┐
+│ salary_p50 ┆ salary_p90 │
+│ ---        ┆ ---        │
+│ f64        ┆ f64        │
+╞════════════╪════════════╡
+│ 58000.0    ┆ 67000.0    │
+└────────────┴────────────┘`
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("QuantileByInterpolationName", func(t *testing.T) {
+		linear, err := ParseQuantileInterp("linear")
+		require.NoError(t, err)
+		nearest, err := ParseQuantileInterp("nearest")
+		require.NoError(t, err)
+		_, err = ParseQuantileInterp("cubic")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `unknown quantile interpolation "cubic"`)
+
+		result, err := ReadCSV("../testdata/sample.csv").SelectExpr(
+			Col("salary").Quantile(0.95, linear).Alias("p95"),
+			Col("salary").Quantile(0.95, nearest).Alias("p95_nearest"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: p95 sits at rank 5.7 of the 7 sorted salaries (65000 + 0.7*5000)
+		expected := `shape: (1, 2)
+┌─────────┬─────────────┐
+│ p95     ┆ p95_nearest │
+│ ---     ┆ ---         │
+│ f64     ┆ f64         │
+╞═════════╪═════════════╡
+│ 68500.0 ┆ 70000.0     │
+└─────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ArgMaxArgMin", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.GroupBy("department").
+			Agg(
+				Col("salary").ArgMax().Alias("top_idx"),
+				Col("salary").ArgMin().Alias("bottom_idx"),
+				Col("name").Count().Alias("employees"),
+			).
+			Sort([]string{"department"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: indices are relative to each group (Engineering: Alice, Charlie, Eve)
+		expected := `shape: (3, 4)
+┌─────────────┬─────────┬────────────┬───────────┐
+│ department  ┆ top_idx ┆ bottom_idx ┆ employees │
+│ ---         ┆ ---     ┆ ---        ┆ ---       │
+│ str         ┆ u32     ┆ u32        ┆ u32       │
+╞═════════════╪═════════╪════════════╪═══════════╡
+│ Engineering ┆ 1       ┆ 0          ┆ 3         │
+│ Marketing   ┆ 0       ┆ 1          ┆ 2         │
+│ Sales       ┆ 0       ┆ 1          ┆ 2         │
+└─────────────┴─────────┴────────────┴───────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		frame, err := ReadCSV("../testdata/sample.csv").SelectExpr(
+			Col("salary").ArgMax().Alias("top_idx"),
+			Col("salary").ArgMin().Alias("bottom_idx"),
+		).Collect()
+		require.NoError(t, err)
+		defer frame.Release()
+
+		// Golden test: over the whole frame Charlie (row 2) earns most and Alice (row 0) least
+		expected = `shape: (1, 2)
+┌─────────┬────────────┐
+│ top_idx ┆ bottom_idx │
+│ ---     ┆ ---        │
+│ u32     ┆ u32        │
+╞═════════╪════════════╡
+│ 2       ┆ 0          │
+└─────────┴────────────┘`
+
+		require.Equal(t, expected, frame.String())
+	})
+
+	t.Run("SkewAndKurtosis", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").SelectExpr(
+			Col("salary").Skew(true).Alias("skew"),
+			Col("salary").Skew(false).Alias("skew_unbiased"),
+			Col("salary").Kurtosis(true, true).Alias("excess_kurtosis"),
+			Col("salary").Kurtosis(false, false).Alias("kurtosis_unbiased"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: salaries are right-skewed and flatter than a normal distribution
+		expected := `shape: (1, 4)
+┌──────────┬───────────────┬─────────────────┬───────────────────┐
+│ skew     ┆ skew_unbiased ┆ excess_kurtosis ┆ kurtosis_unbiased │
+│ ---      ┆ ---           ┆ ---             ┆ ---               │
+│ f64      ┆ f64           ┆ f64             ┆ f64               │
+╞══════════╪═══════════════╪═════════════════╪═══════════════════╡
+│ 0.400911 ┆ 0.51964       ┆ -1.011988       ┆ 2.371228          │
+└──────────┴───────────────┴─────────────────┴───────────────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").SelectExpr(Col("name").Skew(true)).Collect()
+		require.Error(t, err)
+	})
+
+	t.Run("Product", func(t *testing.T) {
+		growth := []byte("portfolio,growth_factor\nA,1.1\nB,0.9\nA,1.2\nB,1.5\n")
+		result, err := ReadCSVBytes(growth, CSVReadConfig{HasHeader: true}).WithColumns(
+			Col("growth_factor").Product().Over("portfolio").Alias("compounded"),
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: A compounds 1.1 * 1.2, B compounds 0.9 * 1.5
+		expected := `shape: (4, 3)
+┌───────────┬───────────────┬────────────┐
+│ portfolio ┆ growth_factor ┆ compounded │
+│ ---       ┆ ---           ┆ ---        │
+│ str       ┆ f64           ┆ f64        │
+╞═══════════╪═══════════════╪════════════╡
+│ A         ┆ 1.1           ┆ 1.32       │
+│ B         ┆ 0.9           ┆ 1.35       │
+│ A         ┆ 1.2           ┆ 1.32       │
+│ B         ┆ 1.5           ┆ 1.35       │
+└───────────┴───────────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		ages, err := ReadCSV("../testdata/sample.csv").SelectExpr(
+			Col("age").Product().Alias("age_product"),
+		).Collect()
+		require.NoError(t, err)
+		defer ages.Release()
+
+		// Golden test: integer products stay Int64
+		expected = `shape: (1, 1)
+┌─────────────┐
+│ age_product │
+│ ---         │
+│ i64         │
+╞═════════════╡
+│ 18416160000 │
+└─────────────┘`
+
+		require.Equal(t, expected, ages.String())
+	})
+
+	t.Run("MultipleAggregations", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.GroupBy("department").
+			Agg(
+				Col("salary").Mean().Alias("avg_salary"),
+				Col("name").Count().Alias("employee_count"),
+				Col("age").Max().Alias("max_age"),
+			).
+			Sort([]string{"avg_salary"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: multiple aggregations
+		expected := `shape: (3, 4)
+┌─────────────┬──────────────┬────────────────┬─────────┐
+│ department  ┆ avg_salary   ┆ employee_count ┆ max_age │
+│ ---         ┆ ---          ┆ ---            ┆ ---     │
+│ str         ┆ f64          ┆ u32            ┆ i64     │
+╞═════════════╪══════════════╪════════════════╪═════════╡
+│ Sales       ┆ 53500.0      ┆ 2              ┆ 28      │
+│ Marketing   ┆ 59000.0      ┆ 2              ┆ 30      │
+│ Engineering ┆ 61666.666667 ┆ 3              ┆ 35      │
+└─────────────┴──────────────┴────────────────┴─────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+	t.Run("SameColumnMultipleAggregations", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.GroupBy("department").
+			Agg(Col("salary").Min(), Col("salary").Max()).
+			Sort([]string{"department"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: unaliased same-column aggregations get predictable suffixes
+		expected := `shape: (3, 3)
+┌─────────────┬────────────┬────────────┐
+│ department  ┆ salary_min ┆ salary_max │
+│ ---         ┆ ---        ┆ ---        │
+│ str         ┆ i64        ┆ i64        │
+╞═════════════╪════════════╪════════════╡
+│ Engineering ┆ 50000      ┆ 70000      │
+│ Marketing   ┆ 58000      ┆ 60000      │
+│ Sales       ┆ 52000      ┆ 55000      │
+└─────────────┴────────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("DuplicateAggregationAliases", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		_, err := df.GroupBy("department").
+			Agg(Col("salary").Min().Alias("stat"), Col("age").Max().Alias("stat")).
+			Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "duplicate output column")
+		require.Contains(t, err.Error(), "stat")
+	})
+
+	t.Run("GroupByStable", func(t *testing.T) {
+		youngestPerDepartment := func() string {
+			result, err := ReadCSV("../testdata/sample.csv").
+				SortBy([]SortField{Asc("age")}).
+				GroupByStable("department").
+				Agg(Col("name").First().Alias("youngest")).
+				Collect()
+			require.NoError(t, err)
+			defer result.Release()
+			return result.String()
+		}
+
+		// Golden test: departments in the order they first appear by age, with no Sort()
+		expected := `shape: (3, 2)
+┌─────────────┬──────────┐
+│ department  ┆ youngest │
+│ ---         ┆ ---      │
+│ str         ┆ str      │
+╞═════════════╪══════════╡
+│ Engineering ┆ Alice    │
+│ Sales       ┆ Grace    │
+│ Marketing   ┆ Frank    │
+└─────────────┴──────────┘`
+		for range 5 {
+			require.Equal(t, expected, youngestPerDepartment())
+		}
+
+		_, err := ReadCSV("../testdata/sample.csv").GroupByStable().Agg(Col("salary").Sum()).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "GroupByStable() requires at least one expression")
+	})
+
+	t.Run("StrJoinAggregation", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			GroupBy("department").
+			Agg(Col("name").StrJoin(", ").Alias("members")).
+			Sort([]string{"department"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: names keep their row order within each group
+		expected := `shape: (3, 2)
+┌─────────────┬─────────────────────┐
+│ department  ┆ members             │
+│ ---         ┆ ---                 │
+│ str         ┆ str                 │
+╞═════════════╪═════════════════════╡
+│ Engineering ┆ Alice, Charlie, Eve │
+│ Marketing   ┆ Bob, Frank          │
+│ Sales       ┆ Diana, Grace        │
+└─────────────┴─────────────────────┘`
+		require.Equal(t, expected, result.String())
+
+		// Without a group the whole column becomes one string
+		result, err = ReadCSV("../testdata/sample.csv").
+			Limit(3).
+			SelectExpr(Col("name").StrJoin("|")).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		names, _, err := result.ColumnString("name")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Alice|Bob|Charlie"}, names)
+	})
+
+	t.Run("LenAggregation", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			GroupBy("department").
+			Agg(Len().Alias("n")).
+			Sort([]string{"department"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (3, 2)
+┌─────────────┬─────┐
+│ department  ┆ n   │
+│ ---         ┆ --- │
+│ str         ┆ u32 │
+╞═════════════╪═════╡
+│ Engineering ┆ 3   │
+│ Marketing   ┆ 2   │
+│ Sales       ┆ 2   │
+└─────────────┴─────┘`
+		require.Equal(t, expected, result.String())
+
+		// Len counts the null row that Count skips
+		collected, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Limit(2).Collect()
+		require.NoError(t, err)
+		defer collected.Release()
+
+		result, err = collected.addNullRowForTesting().
+			SelectExpr(Len().Alias("rows"), Col("name").Count().Alias("names")).
+			Collect()
+		require.NoError(t, err)
+
+		expected = `shape: (1, 2)
+┌──────┬───────┐
+│ rows ┆ names │
+│ ---  ┆ ---   │
+│ u32  ┆ u32   │
+╞══════╪═══════╡
+│ 3    ┆ 2     │
+└──────┴───────┘`
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("NullCounts", func(t *testing.T) {
+		config := CSVReadConfig{HasHeader: true, NullValues: []string{"NA", "NULL", "-"}}
+		result, err := ReadCSVWithConfig("../testdata/sensors.csv", config).NullCount().Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: one row of per-column null counts
+		expected := `shape: (1, 3)
+┌────────┬─────────┬────────┐
+│ sensor ┆ reading ┆ status │
+│ ---    ┆ ---     ┆ ---    │
+│ u32    ┆ u32     ┆ u32    │
+╞════════╪═════════╪════════╡
+│ 0      ┆ 2       ┆ 2      │
+└────────┴─────────┴────────┘`
+		require.Equal(t, expected, result.String())
+
+		result, err = ReadCSVWithConfig("../testdata/sensors.csv", config).
+			GroupByStable("status").
+			Agg(Col("reading").NullCount().Alias("missing_readings")).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (2, 2)
+┌────────┬──────────────────┐
+│ status ┆ missing_readings │
+│ ---    ┆ ---              │
+│ str    ┆ u32              │
+╞════════╪══════════════════╡
+│ ok     ┆ 1                │
+│ null   ┆ 1                │
+└────────┴──────────────────┘`
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ValueCounts", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").ValueCounts("department", true, false).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: most frequent first, Marketing/Sales tie ordered by value
+		expected := `shape: (3, 2)
+┌─────────────┬───────┐
+│ department  ┆ count │
+│ ---         ┆ ---   │
+│ str         ┆ u32   │
+╞═════════════╪═══════╡
+│ Engineering ┆ 3     │
+│ Marketing   ┆ 2     │
+│ Sales       ┆ 2     │
+└─────────────┴───────┘`
+		require.Equal(t, expected, result.String())
+
+		result, err = ReadCSV("../testdata/sample.csv").
+			Filter(Col("department").Ne(Lit("Sales"))).
+			ValueCounts("department", true, true).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (2, 2)
+┌─────────────┬────────────┐
+│ department  ┆ proportion │
+│ ---         ┆ ---        │
+│ str         ┆ f64        │
+╞═════════════╪════════════╡
+│ Engineering ┆ 0.6        │
+│ Marketing   ┆ 0.4        │
+└─────────────┴────────────┘`
+		require.Equal(t, expected, result.String())
+
+		// Integer values; unsorted output is put in order afterwards
+		result, err = ReadCSV("../testdata/sample.csv").
+			WithColumns(Col("age").Div(Lit(10)).Cast(Int64).Alias("decade")).
+			ValueCounts("decade", false, false).
+			Sort([]string{"decade"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (2, 2)
+┌────────┬───────┐
+│ decade ┆ count │
+│ ---    ┆ ---   │
+│ i64    ┆ u32   │
+╞════════╪═══════╡
+│ 2      ┆ 4     │
+│ 3      ┆ 3     │
+└────────┴───────┘`
+		require.Equal(t, expected, result.String())
+	})
+}
+
+// TestSQLExpressions demonstrates the key ...any functionality with SQL strings
+func TestSQLExpressions(t *testing.T) {
+	t.Run("SelectWithMixedSQLAndFluent", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.Select(
+			"name",                                      // SQL string (column name)
+			"salary * 1.1 as bonus_salary",             // SQL expression with alias
+			Col("age").Add(Lit(5)).Alias("age_plus_5"), // Fluent API
+			"department",                                // SQL string (column name)
+		).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: Mixed SQL and fluent expressions in same Select call
+		expected := `shape: (7, 4)
+┌─────────┬──────────────┬────────────┬─────────────┐
+│ name    ┆ bonus_salary ┆ age_plus_5 ┆ department  │
+│ ---     ┆ ---          ┆ ---        ┆ ---         │
+│ str     ┆ f64          ┆ i64        ┆ str         │
+╞═════════╪══════════════╪════════════╪═════════════╡
+│ Alice   ┆ 55000.0      ┆ 30         ┆ Engineering │
+│ Bob     ┆ 66000.0      ┆ 35         ┆ Marketing   │
+│ Charlie ┆ 77000.0      ┆ 40         ┆ Engineering │
+│ Diana   ┆ 60500.0      ┆ 33         ┆ Sales       │
+│ Eve     ┆ 71500.0      ┆ 37         ┆ Engineering │
+│ Frank   ┆ 63800.0      ┆ 34         ┆ Marketing   │
+│ Grace   ┆ 57200.0      ┆ 32         ┆ Sales       │
+└─────────┴──────────────┴────────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("FilterWithSQLExpressions", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.Filter("salary > 55000 AND department = 'Engineering'").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: SQL filter expression
+		expected := `shape: (2, 4)
+┌─────────┬─────┬────────┬─────────────┐
+│ name    ┆ age ┆ salary ┆ department  │
+│ ---     ┆ --- ┆ ---    ┆ ---         │
+│ str     ┆ i64 ┆ i64    ┆ str         │
+╞═════════╪═════╪════════╪═════════════╡
+│ Charlie ┆ 35  ┆ 70000  ┆ Engineering │
+│ Eve     ┆ 32  ┆ 65000  ┆ Engineering │
+└─────────┴─────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("WithColumnsMixedSQLAndFluent", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.WithColumns(
+			"salary * 1.2 as boosted_salary",                                    // SQL expression
+			Col("age").Gt(Lit(30)).Alias("is_senior"),                         // Fluent boolean
+			"CASE WHEN age > 30 THEN 'senior' ELSE 'junior' END as seniority", // SQL CASE
+			Col("salary").Div(Lit(12)).Alias("monthly_salary"),                // Fluent arithmetic
+			"LENGTH(name) as name_length",                                       // SQL function
+		).Select("name", "boosted_salary", "is_senior", "seniority", "monthly_salary", "name_length").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: Mixed SQL and fluent expressions in WithColumns
+		expected := `shape: (7, 6)
+┌─────────┬────────────────┬───────────┬───────────┬────────────────┬─────────────┐
+│ name    ┆ boosted_salary ┆ is_senior ┆ seniority ┆ monthly_salary ┆ name_length │
+│ ---     ┆ ---            ┆ ---       ┆ ---       ┆ ---            ┆ ---         │
+│ str     ┆ f64            ┆ bool      ┆ str       ┆ i64            ┆ u32         │
+╞═════════╪════════════════╪═══════════╪═══════════╪════════════════╪═════════════╡
+│ Alice   ┆ 60000.0        ┆ false     ┆ junior    ┆ 4166           ┆ 5           │
+│ Bob     ┆ 72000.0        ┆ false     ┆ junior    ┆ 5000           ┆ 3           │
+│ Charlie ┆ 84000.0        ┆ true      ┆ senior    ┆ 5833           ┆ 7           │
+│ Diana   ┆ 66000.0        ┆ false     ┆ junior    ┆ 4583           ┆ 5           │
+│ Eve     ┆ 78000.0        ┆ true      ┆ senior    ┆ 5416           ┆ 3           │
+│ Frank   ┆ 69600.0        ┆ false     ┆ junior    ┆ 4833           ┆ 5           │
+│ Grace   ┆ 62400.0        ┆ false     ┆ junior    ┆ 4333           ┆ 5           │
+└─────────┴────────────────┴───────────┴───────────┴────────────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("GroupByWithMixedAggregations", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.GroupBy("department").
+			Agg(
+				"AVG(salary) as avg_salary",                    // SQL aggregation
+				Col("name").Count().Alias("employee_count"),   // Fluent aggregation
+				"MAX(age) as max_age",                          // SQL aggregation
+				Col("salary").Min().Alias("min_salary"),       // Fluent aggregation
+			).
+			Sort([]string{"avg_salary"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: Mixed SQL and fluent aggregations
+		expected := `shape: (3, 5)
+┌─────────────┬──────────────┬────────────────┬─────────┬────────────┐
+│ department  ┆ avg_salary   ┆ employee_count ┆ max_age ┆ min_salary │
+│ ---         ┆ ---          ┆ ---            ┆ ---     ┆ ---        │
+│ str         ┆ f64          ┆ u32            ┆ i64     ┆ i64        │
+╞═════════════╪══════════════╪════════════════╪═════════╪════════════╡
+│ Sales       ┆ 53500.0      ┆ 2              ┆ 28      ┆ 52000      │
+│ Marketing   ┆ 59000.0      ┆ 2              ┆ 30      ┆ 58000      │
+│ Engineering ┆ 61666.666667 ┆ 3              ┆ 35      ┆ 50000      │
+└─────────────┴──────────────┴────────────────┴─────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("ComplexMixedSQLAndFluentChain", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.
+			WithColumns(
+				"salary * 1.15 as adjusted_salary",              // SQL expression
+				Col("age").Gt(Lit(30)).Alias("is_experienced"), // Fluent boolean
+				"UPPER(name) as name_upper",                     // SQL function
+			).
+			Select(
+				"name",                                          // SQL column
+				Col("adjusted_salary").Alias("final_salary"),   // Fluent (referencing SQL-created column)
+				"is_experienced",                                // SQL column (referencing fluent-created column)
+				"department",                                    // SQL column
+			).
+			Filter(
+				Col("final_salary").Gt(Lit(60000)).And(        // Fluent filter
+					Col("is_experienced").Eq(Lit(true)),        // Fluent boolean check
+				),
+			).
+			Sort([]string{"final_salary"}).                     // SQL sort
+			Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: Mixed SQL and fluent expressions in same Select call
-		expected := `shape: (7, 4)
-┌─────────┬──────────────┬────────────┬─────────────┐
-│ name    ┆ bonus_salary ┆ age_plus_5 ┆ department  │
-│ ---     ┆ ---          ┆ ---        ┆ ---         │
-│ str     ┆ f64          ┆ i64        ┆ str         │
-╞═════════╪══════════════╪════════════╪═════════════╡
-│ Alice   ┆ 55000.0      ┆ 30         ┆ Engineering │
-│ Bob     ┆ 66000.0      ┆ 35         ┆ Marketing   │
-│ Charlie ┆ 77000.0      ┆ 40         ┆ Engineering │
-│ Diana   ┆ 60500.0      ┆ 33         ┆ Sales       │
-│ Eve     ┆ 71500.0      ┆ 37         ┆ Engineering │
-│ Frank   ┆ 63800.0      ┆ 34         ┆ Marketing   │
-│ Grace   ┆ 57200.0      ┆ 32         ┆ Sales       │
-└─────────┴──────────────┴────────────┴─────────────┘`
+		// Golden test: complex chaining with mixed APIs
+		expected := `shape: (2, 4)
+┌─────────┬──────────────┬────────────────┬─────────────┐
+│ name    ┆ final_salary ┆ is_experienced ┆ department  │
+│ ---     ┆ ---          ┆ ---            ┆ ---         │
+│ str     ┆ f64          ┆ bool           ┆ str         │
+╞═════════╪══════════════╪════════════════╪═════════════╡
+│ Eve     ┆ 74750.0      ┆ true           ┆ Engineering │
+│ Charlie ┆ 80500.0      ┆ true           ┆ Engineering │
+└─────────┴──────────────┴────────────────┴─────────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
 
-	t.Run("FilterWithSQLExpressions", func(t *testing.T) {
+	t.Run("MixedGroupByWithComplexExpressions", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.Filter("salary > 55000 AND department = 'Engineering'").Collect()
+		result, err := df.
+			GroupBy(
+				"department",                                    // SQL column
+				Col("age").Gt(Lit(30)).Alias("is_senior"),     // Fluent boolean grouping
+			).
+			Agg(
+				"COUNT(*) as count",                             // SQL aggregation
+				Col("salary").Mean().Alias("avg_salary"),       // Fluent aggregation
+				"MAX(salary) - MIN(salary) as salary_range",    // SQL expression
+				Col("name").First().Alias("first_name"),        // Fluent aggregation
+			).
+			Sort([]string{"department", "is_senior"}).
+			Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: SQL filter expression
-		expected := `shape: (2, 4)
+		// Golden test: complex mixed grouping and aggregation (empty groups filtered out)
+		expected := `shape: (4, 6)
+┌─────────────┬───────────┬───────┬────────────┬──────────────┬────────────┐
+│ department  ┆ is_senior ┆ count ┆ avg_salary ┆ salary_range ┆ first_name │
+│ ---         ┆ ---       ┆ ---   ┆ ---        ┆ ---          ┆ ---        │
+│ str         ┆ bool      ┆ u32   ┆ f64        ┆ i64          ┆ str        │
+╞═════════════╪═══════════╪═══════╪════════════╪══════════════╪════════════╡
+│ Engineering ┆ false     ┆ 1     ┆ 50000.0    ┆ 0            ┆ Alice      │
+│ Engineering ┆ true      ┆ 2     ┆ 67500.0    ┆ 5000         ┆ Charlie    │
+│ Marketing   ┆ false     ┆ 2     ┆ 59000.0    ┆ 2000         ┆ Bob        │
+│ Sales       ┆ false     ┆ 2     ┆ 53500.0    ┆ 3000         ┆ Diana      │
+└─────────────┴───────────┴───────┴────────────┴──────────────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+}
+
+// TestAdvancedFeatures demonstrates sorting, limiting, and SQL operations
+func TestAdvancedFeatures(t *testing.T) {
+	t.Run("SortAndLimit", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.Sort([]string{"salary"}).Limit(3).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: sort by salary (ascending) and limit to top 3
+		expected := `shape: (3, 4)
+┌───────┬─────┬────────┬─────────────┐
+│ name  ┆ age ┆ salary ┆ department  │
+│ ---   ┆ --- ┆ ---    ┆ ---         │
+│ str   ┆ i64 ┆ i64    ┆ str         │
+╞═══════╪═════╪════════╪═════════════╡
+│ Alice ┆ 25  ┆ 50000  ┆ Engineering │
+│ Grace ┆ 27  ┆ 52000  ┆ Sales       │
+│ Diana ┆ 28  ┆ 55000  ┆ Sales       │
+└───────┴─────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("HeadAndTail", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Sort([]string{"salary"}).Tail(3).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the last 3 rows after sorting are the highest salaries
+		expected := `shape: (3, 4)
+┌─────────┬─────┬────────┬─────────────┐
+│ name    ┆ age ┆ salary ┆ department  │
+│ ---     ┆ --- ┆ ---    ┆ ---         │
+│ str     ┆ i64 ┆ i64    ┆ str         │
+╞═════════╪═════╪════════╪═════════════╡
+│ Bob     ┆ 30  ┆ 60000  ┆ Marketing   │
+│ Eve     ┆ 32  ┆ 65000  ┆ Engineering │
+│ Charlie ┆ 35  ┆ 70000  ┆ Engineering │
+└─────────┴─────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		head, err := ReadCSV("../testdata/sample.csv").Select("name").Head(2).Collect()
+		require.NoError(t, err)
+		defer head.Release()
+
+		expected = `shape: (2, 1)
+┌───────┐
+│ name  │
+│ ---   │
+│ str   │
+╞═══════╡
+│ Alice │
+│ Bob   │
+└───────┘`
+
+		require.Equal(t, expected, head.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").Tail(0).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Tail() requires n > 0")
+	})
+
+	t.Run("SampleWithSeed", func(t *testing.T) {
+		sample := func(n int, fraction float64) (string, int) {
+			result, err := ReadCSV("../testdata/sample.csv").Sample(n, fraction, false, 42).Collect()
+			require.NoError(t, err)
+			defer result.Release()
+			height, err := result.Height()
+			require.NoError(t, err)
+			return result.String(), height
+		}
+
+		// A fixed seed makes the sample reproducible
+		first, height := sample(3, 0)
+		require.Equal(t, 3, height)
+		second, _ := sample(3, 0)
+		require.Equal(t, first, second)
+
+		_, height = sample(0, 0.5) // floor(7 * 0.5) rows
+		require.Equal(t, 3, height)
+
+		_, err := ReadCSV("../testdata/sample.csv").Sample(2, 0.5, false, 42).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Sample() requires exactly one of n > 0 or fraction > 0")
+
+		// Without replacement the sample cannot be larger than the frame
+		_, err = ReadCSV("../testdata/sample.csv").Sample(10, 0, false, 42).Collect()
+		require.Error(t, err)
+	})
+
+	t.Run("SlicePages", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Slice(2, 2).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the second page of two rows
+		expected := `shape: (2, 2)
+┌─────────┬─────┐
+│ name    ┆ age │
+│ ---     ┆ --- │
+│ str     ┆ i64 │
+╞═════════╪═════╡
+│ Charlie ┆ 35  │
+│ Diana   ┆ 28  │
+└─────────┴─────┘`
+		require.Equal(t, expected, result.String())
+
+		tail, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Slice(-2, 5).Collect()
+		require.NoError(t, err)
+		defer tail.Release()
+
+		// Golden test: a negative offset counts from the end and length is capped
+		expected = `shape: (2, 2)
+┌───────┬─────┐
+│ name  ┆ age │
+│ ---   ┆ --- │
+│ str   ┆ i64 │
+╞═══════╪═════╡
+│ Frank ┆ 29  │
+│ Grace ┆ 27  │
+└───────┴─────┘`
+		require.Equal(t, expected, tail.String())
+
+		// Paging past the end is empty, not an error
+		empty, err := ReadCSV("../testdata/sample.csv").Slice(10, 3).Collect()
+		require.NoError(t, err)
+		defer empty.Release()
+		height, err := empty.Height()
+		require.NoError(t, err)
+		require.Equal(t, 0, height)
+
+		// Lengths beyond 32 bits are rejected rather than clamped
+		_, err = ReadCSV("../testdata/sample.csv").Slice(0, math.MaxUint32+1).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Slice() length")
+	})
+
+	t.Run("ColumnsAndWidth", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			Select("name", Col("salary").Mul(Lit(2)).Alias("double_salary")).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		columns, err := result.Columns()
+		require.NoError(t, err)
+		require.Equal(t, []string{"name", "double_salary"}, columns)
+		width, err := result.Width()
+		require.NoError(t, err)
+		require.Equal(t, 2, width)
+
+		// Both need an executed frame, like Height()
+		lazy := ReadCSV("../testdata/sample.csv")
+		defer lazy.Release()
+		_, err = lazy.Columns()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "must be executed")
+		_, err = lazy.Width()
+		require.Error(t, err)
+	})
+
+	t.Run("Reverse", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Reverse().Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: rows come out in exactly the opposite order of the file
+		expected := `shape: (7, 2)
+┌─────────┬─────┐
+│ name    ┆ age │
+│ ---     ┆ --- │
+│ str     ┆ i64 │
+╞═════════╪═════╡
+│ Grace   ┆ 27  │
+│ Frank   ┆ 29  │
+│ Eve     ┆ 32  │
+│ Diana   ┆ 28  │
+│ Charlie ┆ 35  │
+│ Bob     ┆ 30  │
+│ Alice   ┆ 25  │
+└─────────┴─────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("CollectStreaming", func(t *testing.T) {
+		query := func() *DataFrame {
+			return ReadCSV("../testdata/sample.csv").
+				Filter(Col("age").Gt(Lit(26))).
+				GroupBy("department").
+				Agg(Col("salary").Sum().Alias("total"), Col("name").Count().Alias("people")).
+				Sort([]string{"department"})
+		}
+
+		inMemory, err := query().Collect()
+		require.NoError(t, err)
+		defer inMemory.Release()
+
+		streamed, err := query().CollectStreaming()
+		require.NoError(t, err)
+		defer streamed.Release()
+
+		// Golden test: the streaming engine gives the same result
+		expected := `shape: (3, 3)
+┌─────────────┬────────┬────────┐
+│ department  ┆ total  ┆ people │
+│ ---         ┆ ---    ┆ ---    │
+│ str         ┆ i64    ┆ u32    │
+╞═════════════╪════════╪════════╡
+│ Engineering ┆ 135000 ┆ 2      │
+│ Marketing   ┆ 118000 ┆ 2      │
+│ Sales       ┆ 107000 ┆ 2      │
+└─────────────┴────────┴────────┘`
+		require.Equal(t, expected, streamed.String())
+		require.Equal(t, inMemory.String(), streamed.String())
+	})
+
+	t.Run("CollectCtx", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			Filter(Col("age").Gt(Lit(30))).
+			Select("name", "age").
+			CollectCtx(context.Background())
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: an uncancelled context collects like Collect
+		expected := `shape: (2, 2)
+┌─────────┬─────┐
+│ name    ┆ age │
+│ ---     ┆ --- │
+│ str     ┆ i64 │
+╞═════════╪═════╡
+│ Charlie ┆ 35  │
+│ Eve     ┆ 32  │
+└─────────┴─────┘`
+		require.Equal(t, expected, result.String())
+
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = ReadCSV("../testdata/sample.csv").CollectCtx(cancelled)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// A cross join of seven copies (823543 rows) sorted on every column outlives the deadline
+		column := func(i int) *DataFrame {
+			return ReadCSV("../testdata/sample.csv").Select(Col("salary").Alias(fmt.Sprintf("s%d", i)))
+		}
+		heavy := column(0)
+		sortBy := []string{"s0"}
+		for i := 1; i < 7; i++ {
+			heavy = heavy.CrossJoin(column(i))
+			sortBy = append(sortBy, fmt.Sprintf("s%d", i))
+		}
+		timeout, cancelTimeout := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancelTimeout()
+		_, err = heavy.Sort(sortBy).CollectCtx(timeout)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("FetchPreview", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv").
+			Filter(Col("age").Gt(Lit(26))).
+			Select("name", "age")
+		result, err := df.Fetch(5)
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: only the first 5 source rows are read, and Alice (25) is filtered out
+		expected := `shape: (4, 2)
+┌─────────┬─────┐
+│ name    ┆ age │
+│ ---     ┆ --- │
+│ str     ┆ i64 │
+╞═════════╪═════╡
+│ Bob     ┆ 30  │
+│ Charlie ┆ 35  │
+│ Diana   ┆ 28  │
+│ Eve     ┆ 32  │
+└─────────┴─────┘`
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").Fetch(0)
+		require.Error(t, err)
+	})
+
+	t.Run("GatherEvery", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.GatherEvery(2, 0).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: every second row starting with the first
+		expected := `shape: (4, 4)
 ┌─────────┬─────┬────────┬─────────────┐
 │ name    ┆ age ┆ salary ┆ department  │
 │ ---     ┆ --- ┆ ---    ┆ ---         │
 │ str     ┆ i64 ┆ i64    ┆ str         │
 ╞═════════╪═════╪════════╪═════════════╡
+│ Alice   ┆ 25  ┆ 50000  ┆ Engineering │
 │ Charlie ┆ 35  ┆ 70000  ┆ Engineering │
 │ Eve     ┆ 32  ┆ 65000  ┆ Engineering │
+│ Grace   ┆ 27  ┆ 52000  ┆ Sales       │
 └─────────┴─────┴────────┴─────────────┘`
 
 		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").GatherEvery(0, 0).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "GatherEvery() requires n > 0")
 	})
 
-	t.Run("WithColumnsMixedSQLAndFluent", func(t *testing.T) {
+	t.Run("StableSortWithTies", func(t *testing.T) {
+		// Every department appears more than once, so the sort key has ties
 		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.WithColumns(
-			"salary * 1.2 as boosted_salary",                                    // SQL expression
-			Col("age").Gt(Lit(30)).Alias("is_senior"),                         // Fluent boolean
-			"CASE WHEN age > 30 THEN 'senior' ELSE 'junior' END as seniority", // SQL CASE
-			Col("salary").Div(Lit(12)).Alias("monthly_salary"),                // Fluent arithmetic
-			"LENGTH(name) as name_length",                                       // SQL function
-		).Select("name", "boosted_salary", "is_senior", "seniority", "monthly_salary", "name_length").Collect()
+		result, err := df.SortByStable([]SortField{
+			Asc("department"),
+		}).Select("name", "department").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: tied rows keep their file order within each department
+		expected := `shape: (7, 2)
+┌─────────┬─────────────┐
+│ name    ┆ department  │
+│ ---     ┆ ---         │
+│ str     ┆ str         │
+╞═════════╪═════════════╡
+│ Alice   ┆ Engineering │
+│ Charlie ┆ Engineering │
+│ Eve     ┆ Engineering │
+│ Bob     ┆ Marketing   │
+│ Frank   ┆ Marketing   │
+│ Diana   ┆ Sales       │
+│ Grace   ┆ Sales       │
+└─────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("UniqueSortedByLatestPerKey", func(t *testing.T) {
+		dedupe := func() string {
+			df := ReadCSV("../testdata/sample.csv")
+			result, err := df.UniqueSortedBy([]string{"department"}, Desc("salary")).
+				Select("name", "salary", "department").Collect()
+			require.NoError(t, err)
+			defer result.Release()
+			return result.String()
+		}
+
+		// Golden test: the highest-salary row survives for each department
+		expected := `shape: (3, 3)
+┌─────────┬────────┬─────────────┐
+│ name    ┆ salary ┆ department  │
+│ ---     ┆ ---    ┆ ---         │
+│ str     ┆ i64    ┆ str         │
+╞═════════╪════════╪═════════════╡
+│ Charlie ┆ 70000  ┆ Engineering │
+│ Bob     ┆ 60000  ┆ Marketing   │
+│ Diana   ┆ 55000  ┆ Sales       │
+└─────────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, dedupe())
+		// Repeated runs pick the same rows in the same order
+		require.Equal(t, expected, dedupe())
+	})
+
+	t.Run("UniqueSortedByRequiresOrder", func(t *testing.T) {
+		_, err := ReadCSV("../testdata/sample.csv").UniqueSortedBy([]string{"department"}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "requires at least one sort field")
+	})
+
+	t.Run("UniqueByKeepName", func(t *testing.T) {
+		records := []byte("id,email\n1,a@x.io\n2,b@x.io\n3,a@x.io\n4,c@x.io\n")
+
+		first, err := ParseUniqueKeep("first")
+		require.NoError(t, err)
+		result, err := ReadCSVBytes(records, CSVReadConfig{HasHeader: true}).Unique([]string{"email"}, first).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the later a@x.io record is removed
+		expected := `shape: (3, 2)
+┌─────┬────────┐
+│ id  ┆ email  │
+│ --- ┆ ---    │
+│ i64 ┆ str    │
+╞═════╪════════╡
+│ 1   ┆ a@x.io │
+│ 2   ┆ b@x.io │
+│ 4   ┆ c@x.io │
+└─────┴────────┘`
+		require.Equal(t, expected, result.String())
+
+		none, err := ParseUniqueKeep("none")
+		require.NoError(t, err)
+		result, err = ReadCSVBytes(records, CSVReadConfig{HasHeader: true}).Unique([]string{"email"}, none).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: "none" drops every record whose email repeats
+		expected = `shape: (2, 2)
+┌─────┬────────┐
+│ id  ┆ email  │
+│ --- ┆ ---    │
+│ i64 ┆ str    │
+╞═════╪════════╡
+│ 2   ┆ b@x.io │
+│ 4   ┆ c@x.io │
+└─────┴────────┘`
+		require.Equal(t, expected, result.String())
+
+		_, err = ParseUniqueKeep("latest")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `unknown unique keep strategy "latest"`)
+	})
+
+	t.Run("DropColumns", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Drop("age", "department").Limit(2).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: only name and salary remain, in their original order
+		expected := `shape: (2, 2)
+┌───────┬────────┐
+│ name  ┆ salary │
+│ ---   ┆ ---    │
+│ str   ┆ i64    │
+╞═══════╪════════╡
+│ Alice ┆ 50000  │
+│ Bob   ┆ 60000  │
+└───────┴────────┘`
+
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").Drop("name", "bonus").Collect()
+		require.Error(t, err)
+
+		var polarsErr *Error
+		require.ErrorAs(t, err, &polarsErr)
+		require.Equal(t, "Drop()", polarsErr.Source)
+		require.Contains(t, polarsErr.Message, `column "bonus" not found`)
+	})
+
+	t.Run("RenameColumns", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			Rename(map[string]string{"salary": "pay", "department": "dept"}).
+			Limit(2).
+			Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: Mixed SQL and fluent expressions in WithColumns
-		expected := `shape: (7, 6)
-┌─────────┬────────────────┬───────────┬───────────┬────────────────┬─────────────┐
-│ name    ┆ boosted_salary ┆ is_senior ┆ seniority ┆ monthly_salary ┆ name_length │
-│ ---     ┆ ---            ┆ ---       ┆ ---       ┆ ---            ┆ ---         │
-│ str     ┆ f64            ┆ bool      ┆ str       ┆ i64            ┆ u32         │
-╞═════════╪════════════════╪═══════════╪═══════════╪════════════════╪═════════════╡
-│ Alice   ┆ 60000.0        ┆ false     ┆ junior    ┆ 4166           ┆ 5           │
-│ Bob     ┆ 72000.0        ┆ false     ┆ junior    ┆ 5000           ┆ 3           │
-│ Charlie ┆ 84000.0        ┆ true      ┆ senior    ┆ 5833           ┆ 7           │
-│ Diana   ┆ 66000.0        ┆ false     ┆ junior    ┆ 4583           ┆ 5           │
-│ Eve     ┆ 78000.0        ┆ true      ┆ senior    ┆ 5416           ┆ 3           │
-│ Frank   ┆ 69600.0        ┆ false     ┆ junior    ┆ 4833           ┆ 5           │
-│ Grace   ┆ 62400.0        ┆ false     ┆ junior    ┆ 4333           ┆ 5           │
-└─────────┴────────────────┴───────────┴───────────┴────────────────┴─────────────┘`
+		// Golden test: renamed columns keep their position
+		expected := `shape: (2, 4)
+┌───────┬─────┬───────┬─────────────┐
+│ name  ┆ age ┆ pay   ┆ dept        │
+│ ---   ┆ --- ┆ ---   ┆ ---         │
+│ str   ┆ i64 ┆ i64   ┆ str         │
+╞═══════╪═════╪═══════╪═════════════╡
+│ Alice ┆ 25  ┆ 50000 ┆ Engineering │
+│ Bob   ┆ 30  ┆ 60000 ┆ Marketing   │
+└───────┴─────┴───────┴─────────────┘`
 
 		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").Rename(map[string]string{"bonus": "extra"}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `column "bonus" not found`)
+
+		_, err = ReadCSV("../testdata/sample.csv").Rename(map[string]string{"name": "age"}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `cannot rename "name" to "age": column already exists`)
 	})
 
-	t.Run("GroupByWithMixedAggregations", func(t *testing.T) {
-		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.GroupBy("department").
-			Agg(
-				"AVG(salary) as avg_salary",                    // SQL aggregation
-				Col("name").Count().Alias("employee_count"),   // Fluent aggregation
-				"MAX(age) as max_age",                          // SQL aggregation
-				Col("salary").Min().Alias("min_salary"),       // Fluent aggregation
-			).
-			Sort([]string{"avg_salary"}).
+	t.Run("RenameHelpers", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").
+			Select("name", "salary").
+			WithColumnRenamed("salary", "pay").
+			PrefixColumns("emp_").
+			SuffixColumns("_v1").
+			Limit(2).
 			Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: Mixed SQL and fluent aggregations
-		expected := `shape: (3, 5)
-┌─────────────┬──────────────┬────────────────┬─────────┬────────────┐
-│ department  ┆ avg_salary   ┆ employee_count ┆ max_age ┆ min_salary │
-│ ---         ┆ ---          ┆ ---            ┆ ---     ┆ ---        │
-│ str         ┆ f64          ┆ u32            ┆ i64     ┆ i64        │
-╞═════════════╪══════════════╪════════════════╪═════════╪════════════╡
-│ Sales       ┆ 53500.0      ┆ 2              ┆ 28      ┆ 52000      │
-│ Marketing   ┆ 59000.0      ┆ 2              ┆ 30      ┆ 58000      │
-│ Engineering ┆ 61666.666667 ┆ 3              ┆ 35      ┆ 50000      │
-└─────────────┴──────────────┴────────────────┴─────────┴────────────┘`
+		// Golden test: every column gets the prefix and suffix in its original position
+		expected := `shape: (2, 2)
+┌─────────────┬────────────┐
+│ emp_name_v1 ┆ emp_pay_v1 │
+│ ---         ┆ ---        │
+│ str         ┆ i64        │
+╞═════════════╪════════════╡
+│ Alice       ┆ 50000      │
+│ Bob         ┆ 60000      │
+└─────────────┴────────────┘`
 
 		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").PrefixColumns("").Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "PrefixColumns() requires a non-empty prefix")
 	})
 
-	t.Run("ComplexMixedSQLAndFluentChain", func(t *testing.T) {
-		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.
-			WithColumns(
-				"salary * 1.15 as adjusted_salary",              // SQL expression
-				Col("age").Gt(Lit(30)).Alias("is_experienced"), // Fluent boolean
-				"UPPER(name) as name_upper",                     // SQL function
-			).
-			Select(
-				"name",                                          // SQL column
-				Col("adjusted_salary").Alias("final_salary"),   // Fluent (referencing SQL-created column)
-				"is_experienced",                                // SQL column (referencing fluent-created column)
-				"department",                                    // SQL column
-			).
-			Filter(
-				Col("final_salary").Gt(Lit(60000)).And(        // Fluent filter
-					Col("is_experienced").Eq(Lit(true)),        // Fluent boolean check
-				),
-			).
-			Sort([]string{"final_salary"}).                     // SQL sort
-			Collect()
+	t.Run("DropNullsSubset", func(t *testing.T) {
+		people := []byte("name,age,salary\n" +
+			"Alice,25,50000\n" +
+			"Bob,,60000\n" +
+			"Charlie,35,\n" +
+			"Diana,28,55000\n" +
+			",29,58000\n")
+		result, err := ReadCSVBytes(people, CSVReadConfig{HasHeader: true}).DropNulls("age", "salary").Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: complex chaining with mixed APIs
-		expected := `shape: (2, 4)
-┌─────────┬──────────────┬────────────────┬─────────────┐
-│ name    ┆ final_salary ┆ is_experienced ┆ department  │
-│ ---     ┆ ---          ┆ ---            ┆ ---         │
-│ str     ┆ f64          ┆ bool           ┆ str         │
-╞═════════╪══════════════╪════════════════╪═════════════╡
-│ Eve     ┆ 74750.0      ┆ true           ┆ Engineering │
-│ Charlie ┆ 80500.0      ┆ true           ┆ Engineering │
-└─────────┴──────────────┴────────────────┴─────────────┘`
+		// Golden test: Bob and Charlie are dropped; the unnamed row survives since name is not in the subset
+		expected := `shape: (3, 3)
+┌───────┬─────┬────────┐
+│ name  ┆ age ┆ salary │
+│ ---   ┆ --- ┆ ---    │
+│ str   ┆ i64 ┆ i64    │
+╞═══════╪═════╪════════╡
+│ Alice ┆ 25  ┆ 50000  │
+│ Diana ┆ 28  ┆ 55000  │
+│ null  ┆ 29  ┆ 58000  │
+└───────┴─────┴────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
 
-	t.Run("MixedGroupByWithComplexExpressions", func(t *testing.T) {
-		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.
-			GroupBy(
-				"department",                                    // SQL column
-				Col("age").Gt(Lit(30)).Alias("is_senior"),     // Fluent boolean grouping
-			).
-			Agg(
-				"COUNT(*) as count",                             // SQL aggregation
-				Col("salary").Mean().Alias("avg_salary"),       // Fluent aggregation
-				"MAX(salary) - MIN(salary) as salary_range",    // SQL expression
-				Col("name").First().Alias("first_name"),        // Fluent aggregation
-			).
-			Sort([]string{"department", "is_senior"}).
-			Collect()
+	t.Run("DropNullsAnyColumn", func(t *testing.T) {
+		people := []byte("name,age,salary\n" +
+			"Alice,25,50000\n" +
+			"Bob,,60000\n" +
+			"Charlie,35,\n" +
+			"Diana,28,55000\n" +
+			",29,58000\n")
+		result, err := ReadCSVBytes(people, CSVReadConfig{HasHeader: true}).DropNulls().Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: complex mixed grouping and aggregation (empty groups filtered out)
-		expected := `shape: (4, 6)
-┌─────────────┬───────────┬───────┬────────────┬──────────────┬────────────┐
-│ department  ┆ is_senior ┆ count ┆ avg_salary ┆ salary_range ┆ first_name │
-│ ---         ┆ ---       ┆ ---   ┆ ---        ┆ ---          ┆ ---        │
-│ str         ┆ bool      ┆ u32   ┆ f64        ┆ i64          ┆ str        │
-╞═════════════╪═══════════╪═══════╪════════════╪══════════════╪════════════╡
-│ Engineering ┆ false     ┆ 1     ┆ 50000.0    ┆ 0            ┆ Alice      │
-│ Engineering ┆ true      ┆ 2     ┆ 67500.0    ┆ 5000         ┆ Charlie    │
-│ Marketing   ┆ false     ┆ 2     ┆ 59000.0    ┆ 2000         ┆ Bob        │
-│ Sales       ┆ false     ┆ 2     ┆ 53500.0    ┆ 3000         ┆ Diana      │
-└─────────────┴───────────┴───────┴────────────┴──────────────┴────────────┘`
+		expected := `shape: (2, 3)
+┌───────┬─────┬────────┐
+│ name  ┆ age ┆ salary │
+│ ---   ┆ --- ┆ ---    │
+│ str   ┆ i64 ┆ i64    │
+╞═══════╪═════╪════════╡
+│ Alice ┆ 25  ┆ 50000  │
+│ Diana ┆ 28  ┆ 55000  │
+└───────┴─────┴────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
-}
 
-// TestAdvancedFeatures demonstrates sorting, limiting, and SQL operations
-func TestAdvancedFeatures(t *testing.T) {
-	t.Run("SortAndLimit", func(t *testing.T) {
+	t.Run("SortByExpressions", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
-		result, err := df.Sort([]string{"salary"}).Limit(3).Collect()
+		result, err := df.SortByExpr(
+			DescExprNullsFirst(Col("salary").Div(Col("age"))), // Salary per year of age
+			AscExpr(Col("name")),                               // Break ties alphabetically
+		).Select("name", "age", "salary").Collect()
 		require.NoError(t, err)
 		defer result.Release()
 
-		// Golden test: sort by salary (ascending) and limit to top 3
-		expected := `shape: (3, 4)
-┌───────┬─────┬────────┬─────────────┐
-│ name  ┆ age ┆ salary ┆ department  │
-│ ---   ┆ --- ┆ ---    ┆ ---         │
-│ str   ┆ i64 ┆ i64    ┆ str         │
-╞═══════╪═════╪════════╪═════════════╡
-│ Alice ┆ 25  ┆ 50000  ┆ Engineering │
-│ Grace ┆ 27  ┆ 52000  ┆ Sales       │
-│ Diana ┆ 28  ┆ 55000  ┆ Sales       │
-└───────┴─────┴────────┴─────────────┘`
+		// Golden test: Alice, Bob, Charlie and Frank tie at 2000 and are ordered by name
+		expected := `shape: (7, 3)
+┌─────────┬─────┬────────┐
+│ name    ┆ age ┆ salary │
+│ ---     ┆ --- ┆ ---    │
+│ str     ┆ i64 ┆ i64    │
+╞═════════╪═════╪════════╡
+│ Eve     ┆ 32  ┆ 65000  │
+│ Alice   ┆ 25  ┆ 50000  │
+│ Bob     ┆ 30  ┆ 60000  │
+│ Charlie ┆ 35  ┆ 70000  │
+│ Frank   ┆ 29  ┆ 58000  │
+│ Diana   ┆ 28  ┆ 55000  │
+│ Grace   ┆ 27  ┆ 52000  │
+└─────────┴─────┴────────┘`
 
 		require.Equal(t, expected, result.String())
 	})
@@ -513,6 +3048,63 @@ func TestAdvancedFeatures(t *testing.T) {
 		require.Equal(t, expected, result.String())
 	})
 
+	t.Run("TopKAndBottomK", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").TopK(3, "salary").Select("name", "salary").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: three highest salaries, largest first
+		expected := `shape: (3, 2)
+┌─────────┬────────┐
+│ name    ┆ salary │
+│ ---     ┆ ---    │
+│ str     ┆ i64    │
+╞═════════╪════════╡
+│ Charlie ┆ 70000  │
+│ Eve     ┆ 65000  │
+│ Bob     ┆ 60000  │
+└─────────┴────────┘`
+		require.Equal(t, expected, result.String())
+
+		result, err = ReadCSV("../testdata/sample.csv").BottomK(2, "age").Select("name", "age").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (2, 2)
+┌───────┬─────┐
+│ name  ┆ age │
+│ ---   ┆ --- │
+│ str   ┆ i64 │
+╞═══════╪═════╡
+│ Alice ┆ 25  │
+│ Grace ┆ 27  │
+└───────┴─────┘`
+		require.Equal(t, expected, result.String())
+
+		// Expression keys: lowest salary per year of age
+		result, err = ReadCSV("../testdata/sample.csv").
+			BottomKBy(2, Col("salary").Div(Col("age"))).
+			Select("name", "age", "salary").
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (2, 3)
+┌───────┬─────┬────────┐
+│ name  ┆ age ┆ salary │
+│ ---   ┆ --- ┆ ---    │
+│ str   ┆ i64 ┆ i64    │
+╞═══════╪═════╪════════╡
+│ Grace ┆ 27  ┆ 52000  │
+│ Diana ┆ 28  ┆ 55000  │
+└───────┴─────┴────────┘`
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSV("../testdata/sample.csv").TopK(0, "salary").Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "TopK() requires k > 0")
+	})
+
 	t.Run("SQLQuery", func(t *testing.T) {
 		df := ReadCSV("../testdata/sample.csv")
 		result, err := df.Query("SELECT name, salary FROM df WHERE salary > 60000 ORDER BY salary DESC").Collect()
@@ -533,6 +3125,31 @@ func TestAdvancedFeatures(t *testing.T) {
 		require.Equal(t, expected, result.String())
 	})
 
+	t.Run("SQLQueryThenFluentChain", func(t *testing.T) {
+		df := ReadCSV("../testdata/sample.csv")
+		result, err := df.Query("SELECT name, salary, department FROM df WHERE age > 26").
+			Filter(Col("salary").Gt(Lit(55000))).
+			SortBy([]SortField{Desc("salary")}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: SQL result feeds Go-built filter and sort in one plan
+		expected := `shape: (4, 3)
+┌─────────┬────────┬─────────────┐
+│ name    ┆ salary ┆ department  │
+│ ---     ┆ ---    ┆ ---         │
+│ str     ┆ i64    ┆ str         │
+╞═════════╪════════╪═════════════╡
+│ Charlie ┆ 70000  ┆ Engineering │
+│ Eve     ┆ 65000  ┆ Engineering │
+│ Bob     ┆ 60000  ┆ Marketing   │
+│ Frank   ┆ 58000  ┆ Marketing   │
+└─────────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
 	t.Run("Concatenation", func(t *testing.T) {
 		// Load the same file twice to test concatenation
 		df1, err := ReadCSV("../testdata/sample.csv").Collect()
@@ -578,6 +3195,89 @@ func TestAdvancedFeatures(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 14, height) // 7 + 7 = 14 rows
 	})
+
+	t.Run("ConcatHorizontal", func(t *testing.T) {
+		people, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Limit(3).Collect()
+		require.NoError(t, err)
+		defer people.Release()
+
+		// Columns computed by a separate pipeline over the same rows
+		pay, err := ReadCSV("../testdata/sample.csv").
+			Select(Col("salary").Div(Lit(1000.0)).Alias("salary_k")).
+			Limit(3).
+			Collect()
+		require.NoError(t, err)
+		defer pay.Release()
+
+		result, err := ConcatHorizontal(people, pay).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (3, 3)
+┌─────────┬─────┬──────────┐
+│ name    ┆ age ┆ salary_k │
+│ ---     ┆ --- ┆ ---      │
+│ str     ┆ i64 ┆ f64      │
+╞═════════╪═════╪══════════╡
+│ Alice   ┆ 25  ┆ 50.0     │
+│ Bob     ┆ 30  ┆ 60.0     │
+│ Charlie ┆ 35  ┆ 70.0     │
+└─────────┴─────┴──────────┘`
+		require.Equal(t, expected, result.String())
+
+		full, err := ReadCSV("../testdata/sample.csv").Select("department").Collect()
+		require.NoError(t, err)
+		defer full.Release()
+		_, err = ConcatHorizontal(people, full).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "frame 1 has 7 rows, frame 0 has 3")
+
+		_, err = ConcatHorizontal(people, people).Collect()
+		require.Error(t, err)
+	})
+
+	t.Run("ConcatDiagonal", func(t *testing.T) {
+		collectColumns := func(cols map[string][]any) *DataFrame {
+			df, err := FromColumns(cols)
+			require.NoError(t, err)
+			collected, err := df.Collect() // Collect returns df itself with the executed handle
+			require.NoError(t, err)
+			return collected
+		}
+
+		january := collectColumns(map[string][]any{
+			"month": {"jan", "feb"},
+			"sales": {10, 12},
+		})
+		defer january.Release()
+
+		// Later extract: sales became fractional and a returns column was added
+		march := collectColumns(map[string][]any{
+			"month":   {"mar"},
+			"sales":   {12.5},
+			"returns": {1},
+		})
+		defer march.Release()
+
+		result, err := ConcatDiagonal(january, march).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (3, 3)
+┌───────┬───────┬─────────┐
+│ month ┆ sales ┆ returns │
+│ ---   ┆ ---   ┆ ---     │
+│ str   ┆ f64   ┆ i64     │
+╞═══════╪═══════╪═════════╡
+│ jan   ┆ 10.0  ┆ null    │
+│ feb   ┆ 12.0  ┆ null    │
+│ mar   ┆ 12.5  ┆ 1       │
+└───────┴───────┴─────────┘`
+		require.Equal(t, expected, result.String())
+
+		_, err = Concat(january, march).Collect()
+		require.Error(t, err) // Vertical concat requires matching schemas
+	})
 }
 
 // TestPerformanceBenchmarks - Important benchmark tests for large datasets
@@ -695,39 +3395,154 @@ func TestPerformanceBenchmarks(t *testing.T) {
 		t.Logf("Result: %s", result.String())
 	})
 
-	t.Run("Count100MRowsFullScan", func(t *testing.T) {
+	t.Run("Count100MRowsFullScan", func(t *testing.T) {
+		// Skip if large test files don't exist
+		if !fileExists("../scripts/testdata/weather_data_part_00.csv") {
+			t.Skip("Large weather data files not found. Run scripts/generate_large_csv.py to create test data.")
+		}
+
+		// Test with filter that matches nothing (impossible temperatures)
+		df := ReadCSVWithOptions("../scripts/testdata/weather_data_part_*.csv", true, true)
+
+		start := time.Now()
+		result, err := df.Filter(
+			Col("high_temp").Gt(Lit(60)).Or(Col("low_temp").Lt(Lit(-60))), // Impossible range
+		).Count().Collect()
+		elapsed := time.Since(start)
+
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Should have 0 matches but still return count result
+		expected := `shape: (1, 1)
+┌───────┐
+│ count │
+│ ---   │
+│ u32   │
+╞═══════╡
+│ 0     │
+└───────┘`
+
+		require.Equal(t, expected, result.String())
+
+		// Performance logging
+		rowsPerSecond := float64(100_000_000) / elapsed.Seconds()
+		t.Logf("100M row full scan (no matches) completed in %v (%.2f million rows/second)", elapsed, rowsPerSecond/1_000_000)
+	})
+
+	t.Run("Quantiles100MRows", func(t *testing.T) {
+		// Skip if large test files don't exist
+		if !fileExists("../testdata/weather_data_part_00.csv") {
+			t.Skip("Large weather data files not found. Generate with: python3 scripts/generate_large_csv.py (creates ~3.4GB of test data)")
+		}
+
+		qs := []float64{0.5, 0.9, 0.99}
+
+		// One multi-quantile aggregation, unnested into a column per quantile
+		start := time.Now()
+		combined, err := ReadCSV("../testdata/weather_data_part_*.csv").
+			GroupBy("city").
+			Agg(Col("high_temp").Quantiles(qs, QuantileLinear)).
+			Unnest("high_temp_quantiles").
+			Sort([]string{"city"}).
+			Collect()
+		combinedElapsed := time.Since(start)
+		require.NoError(t, err)
+		defer combined.Release()
+
+		// Three independent quantile aggregations
+		start = time.Now()
+		separate, err := ReadCSV("../testdata/weather_data_part_*.csv").
+			GroupBy("city").
+			Agg(
+				Col("high_temp").Quantile(0.5, QuantileLinear).Alias("high_temp_p50"),
+				Col("high_temp").Quantile(0.9, QuantileLinear).Alias("high_temp_p90"),
+				Col("high_temp").Quantile(0.99, QuantileLinear).Alias("high_temp_p99"),
+			).
+			Sort([]string{"city"}).
+			Collect()
+		separateElapsed := time.Since(start)
+		require.NoError(t, err)
+		defer separate.Release()
+
+		require.Equal(t, separate.String(), combined.String())
+
+		// Performance logging
+		t.Logf("100M row p50/p90/p99: Quantiles %v vs three Quantile calls %v", combinedElapsed, separateElapsed)
+	})
+
+	t.Run("TopK100MRowsVsSortLimit", func(t *testing.T) {
 		// Skip if large test files don't exist
 		if !fileExists("../scripts/testdata/weather_data_part_00.csv") {
 			t.Skip("Large weather data files not found. Run scripts/generate_large_csv.py to create test data.")
 		}
 
-		// Test with filter that matches nothing (impossible temperatures)
-		df := ReadCSVWithOptions("../scripts/testdata/weather_data_part_*.csv", true, true)
-
+		// Partial sort keeping only the 10 largest keys
 		start := time.Now()
-		result, err := df.Filter(
-			Col("high_temp").Gt(Lit(60)).Or(Col("low_temp").Lt(Lit(-60))), // Impossible range
-		).Count().Collect()
-		elapsed := time.Since(start)
-
+		topK, err := ReadCSVWithOptions("../scripts/testdata/weather_data_part_*.csv", true, true).
+			TopK(10, "pressure").
+			Select("pressure").
+			Collect()
+		topKElapsed := time.Since(start)
 		require.NoError(t, err)
-		defer result.Release()
-
-		// Should have 0 matches but still return count result
-		expected := `shape: (1, 1)
-┌───────┐
-│ count │
-│ ---   │
-│ u32   │
-╞═══════╡
-│ 0     │
-└───────┘`
+		defer topK.Release()
+
+		// Full descending sort followed by a limit
+		start = time.Now()
+		sorted, err := ReadCSVWithOptions("../scripts/testdata/weather_data_part_*.csv", true, true).
+			SortBy([]SortField{Desc("pressure")}).
+			Limit(10).
+			Select("pressure").
+			Collect()
+		sortElapsed := time.Since(start)
+		require.NoError(t, err)
+		defer sorted.Release()
 
-		require.Equal(t, expected, result.String())
+		// Rows tied on the key may differ, so only the key values are compared
+		require.Equal(t, sorted.String(), topK.String())
 
 		// Performance logging
-		rowsPerSecond := float64(100_000_000) / elapsed.Seconds()
-		t.Logf("100M row full scan (no matches) completed in %v (%.2f million rows/second)", elapsed, rowsPerSecond/1_000_000)
+		t.Logf("100M row top 10: TopK %v vs SortBy + Limit %v", topKElapsed, sortElapsed)
+	})
+
+	t.Run("CorrMatrix10MRows", func(t *testing.T) {
+		// Skip if large test files don't exist
+		if !fileExists("../testdata/weather_data_part_00.csv") {
+			t.Skip("Large weather data files not found. Generate with: python3 scripts/generate_large_csv.py (creates ~3.4GB of test data)")
+		}
+
+		for _, method := range []CorrMethod{CorrPearson, CorrSpearman} {
+			start := time.Now()
+			result, err := ReadCSV("../testdata/weather_data_part_00.csv").CorrMatrix(method)
+			elapsed := time.Since(start)
+			require.NoError(t, err)
+
+			out, err := result.ToCsv()
+			require.NoError(t, err)
+			require.NoError(t, result.Release())
+
+			records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+			require.NoError(t, err)
+
+			// Header is "column" followed by the numeric columns; each row is labelled
+			header, rows := records[0], records[1:]
+			require.Len(t, rows, len(header)-1)
+			for i, row := range rows {
+				require.Equal(t, header[i+1], row[0])
+				for j := range rows {
+					v, err := strconv.ParseFloat(row[j+1], 64)
+					require.NoError(t, err)
+					mirrored, err := strconv.ParseFloat(rows[j][i+1], 64)
+					require.NoError(t, err)
+					require.Equal(t, v, mirrored, "matrix must be symmetric")
+					if i == j {
+						require.InDelta(t, 1.0, v, 1e-9, "diagonal must be 1.0")
+					}
+				}
+			}
+
+			t.Logf("10M row correlation matrix (method %d) over %d columns in %v", method, len(rows), elapsed)
+		}
 	})
 }
 
@@ -829,6 +3644,82 @@ func TestWindowFunctions(t *testing.T) {
 
 // TestJoinOperations demonstrates join functionality with all join types
 func TestJoinOperations(t *testing.T) {
+	t.Run("AsofJoinTradesWithQuotes", func(t *testing.T) {
+		// Join consumes its left frame, so each join gets freshly collected inputs
+		collect := func(data string) *DataFrame {
+			df, err := ReadCSVBytes([]byte(data), CSVReadConfig{HasHeader: true}).Collect()
+			require.NoError(t, err)
+			t.Cleanup(func() { df.Release() })
+			return df
+		}
+		trades := func() *DataFrame { return collect("time,ticker,qty\n2,A,10\n5,B,20\n9,A,30\n") }
+		quotes := func() *DataFrame { return collect("time,ticker,bid\n1,A,100\n3,B,200\n4,A,101\n8,B,201\n") }
+
+		result, err := trades().JoinAsof(quotes(), AsofJoinSpec{LeftOn: "time", By: []string{"ticker"}}).Collect()
+		require.NoError(t, err)
+
+		// Golden test: each trade takes the latest quote for its ticker at or before its time
+		expected := `shape: (3, 4)
+┌──────┬────────┬─────┬─────┐
+│ time ┆ ticker ┆ qty ┆ bid │
+│ ---  ┆ ---    ┆ --- ┆ --- │
+│ i64  ┆ str    ┆ i64 ┆ i64 │
+╞══════╪════════╪═════╪═════╡
+│ 2    ┆ A      ┆ 10  ┆ 100 │
+│ 5    ┆ B      ┆ 20  ┆ 200 │
+│ 9    ┆ A      ┆ 30  ┆ 101 │
+└──────┴────────┴─────┴─────┘`
+
+		require.Equal(t, expected, result.String())
+
+		nearest, err := trades().JoinAsof(quotes(), AsofJoinSpec{
+			LeftOn:    "time",
+			By:        []string{"ticker"},
+			Strategy:  "nearest",
+			Tolerance: "1",
+		}).Collect()
+		require.NoError(t, err)
+
+		// Golden test: only the A trade at time 2 has a quote within 1 time unit
+		expected = `shape: (3, 4)
+┌──────┬────────┬─────┬──────┐
+│ time ┆ ticker ┆ qty ┆ bid  │
+│ ---  ┆ ---    ┆ --- ┆ ---  │
+│ i64  ┆ str    ┆ i64 ┆ i64  │
+╞══════╪════════╪═════╪══════╡
+│ 2    ┆ A      ┆ 10  ┆ 100  │
+│ 5    ┆ B      ┆ 20  ┆ null │
+│ 9    ┆ A      ┆ 30  ┆ null │
+└──────┴────────┴─────┴──────┘`
+
+		require.Equal(t, expected, nearest.String())
+
+		// The right side may still be lazy; its pending filter is planned with the join
+		lazyQuotes := ReadCSVBytes([]byte("time,ticker,bid\n1,A,100\n3,B,200\n4,A,101\n8,B,201\n"), CSVReadConfig{HasHeader: true}).
+			Filter(Col("bid").Gt(Lit(100)))
+		defer lazyQuotes.Release()
+		result, err = trades().JoinAsof(lazyQuotes, AsofJoinSpec{LeftOn: "time", By: []string{"ticker"}}).Collect()
+		require.NoError(t, err)
+
+		// Golden test: the A trade at time 2 loses its only earlier quote to the filter
+		expected = `shape: (3, 4)
+┌──────┬────────┬─────┬──────┐
+│ time ┆ ticker ┆ qty ┆ bid  │
+│ ---  ┆ ---    ┆ --- ┆ ---  │
+│ i64  ┆ str    ┆ i64 ┆ i64  │
+╞══════╪════════╪═════╪══════╡
+│ 2    ┆ A      ┆ 10  ┆ null │
+│ 5    ┆ B      ┆ 20  ┆ 200  │
+│ 9    ┆ A      ┆ 30  ┆ 101  │
+└──────┴────────┴─────┴──────┘`
+
+		require.Equal(t, expected, result.String())
+
+		_, err = trades().JoinAsof(quotes(), AsofJoinSpec{LeftOn: "time", Strategy: "closest"}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `unknown strategy "closest"`)
+	})
+
 	t.Run("BasicInnerJoin", func(t *testing.T) {
 		// Create left DataFrame with employee data
 		left, err := ReadCSV("../testdata/sample.csv").Collect()
@@ -863,6 +3754,105 @@ func TestJoinOperations(t *testing.T) {
 		require.Equal(t, expected, result.String())
 	})
 
+	t.Run("LazyRightSide", func(t *testing.T) {
+		left, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		defer left.Release()
+
+		// The right side is never collected: its pending ops are planned into the join
+		right := ReadCSV("../testdata/sample.csv").
+			Select("name", "department").
+			Filter(Col("department").Eq(Lit("Engineering")))
+		defer right.Release()
+
+		result, err := left.InnerJoin(right, "name").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected := `shape: (3, 5)
+┌─────────┬─────┬────────┬─────────────┬──────────────────┐
+│ name    ┆ age ┆ salary ┆ department  ┆ department_right │
+│ ---     ┆ --- ┆ ---    ┆ ---         ┆ ---              │
+│ str     ┆ i64 ┆ i64    ┆ str         ┆ str              │
+╞═════════╪═════╪════════╪═════════════╪══════════════════╡
+│ Alice   ┆ 25  ┆ 50000  ┆ Engineering ┆ Engineering      │
+│ Charlie ┆ 35  ┆ 70000  ┆ Engineering ┆ Engineering      │
+│ Eve     ┆ 32  ┆ 65000  ┆ Engineering ┆ Engineering      │
+└─────────┴─────┴────────┴─────────────┴──────────────────┘`
+		require.Equal(t, expected, result.String())
+
+		// Both sides lazy, planned as one query
+		lazyJoin, err := ReadCSV("../testdata/sample.csv").
+			Join(ReadCSV("../testdata/sample.csv").Filter(Col("age").Gt(Lit(30))), On("name")).
+			Collect()
+		require.NoError(t, err)
+		defer lazyJoin.Release()
+		height, err := lazyJoin.Height()
+		require.NoError(t, err)
+		require.Equal(t, 2, height)
+
+		// The right frame keeps its own pending ops and can still be collected
+		collectedRight, err := right.Collect()
+		require.NoError(t, err)
+		height, err = collectedRight.Height()
+		require.NoError(t, err)
+		require.Equal(t, 3, height)
+
+		// Errors already recorded on the right side surface through the join
+		left2, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		defer left2.Release()
+		_, err = left2.InnerJoin(ReadCSV("../testdata/sample.csv").Drop(), "name").Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Join: other DataFrame: Drop() requires at least one column")
+	})
+
+	t.Run("RightChangesBeforeJoinRuns", func(t *testing.T) {
+		engineering := func() *DataFrame {
+			right, err := ReadCSV("../testdata/sample.csv").
+				Select("name", "department").
+				Filter(Col("department").Eq(Lit("Engineering"))).
+				Collect()
+			require.NoError(t, err)
+			return right
+		}
+		expected := `shape: (3, 3)
+┌─────────┬─────┬─────────────┐
+│ name    ┆ age ┆ department  │
+│ ---     ┆ --- ┆ ---         │
+│ str     ┆ i64 ┆ str         │
+╞═════════╪═════╪═════════════╡
+│ Alice   ┆ 25  ┆ Engineering │
+│ Charlie ┆ 35  ┆ Engineering │
+│ Eve     ┆ 32  ┆ Engineering │
+└─────────┴─────┴─────────────┘`
+
+		// Re-collecting the right frame releases the handle the join captured
+		right := engineering()
+		defer right.Release()
+		left := ReadCSV("../testdata/sample.csv").Select("name", "age").Join(right, On("name"))
+		defer left.Release()
+		_, err := right.Filter(Col("name").Eq(Lit("Alice"))).Collect()
+		require.NoError(t, err)
+
+		// Golden test: the join still sees the right frame as it was when Join() was called
+		result, err := left.Collect()
+		require.NoError(t, err)
+		require.Equal(t, expected, result.String())
+		height, err := right.Height()
+		require.NoError(t, err)
+		require.Equal(t, 1, height)
+
+		// Releasing the right frame before the join runs is safe too
+		released := engineering()
+		left2 := ReadCSV("../testdata/sample.csv").Select("name", "age").Join(released, On("name"))
+		defer left2.Release()
+		require.NoError(t, released.Release())
+		result2, err := left2.Collect()
+		require.NoError(t, err)
+		require.Equal(t, expected, result2.String())
+	})
+
 	t.Run("LeftJoinWithSuffix", func(t *testing.T) {
 		// Create left DataFrame
 		left, err := ReadCSV("../testdata/sample.csv").
@@ -1016,6 +4006,69 @@ func TestJoinOperations(t *testing.T) {
 
 		require.Equal(t, expected, result.String())
 	})
+
+	t.Run("OuterJoinWithIndicator", func(t *testing.T) {
+		// Left holds employees under 30, right holds employees 28 and over
+		left, err := ReadCSV("../testdata/sample.csv").
+			Select("name", "salary").
+			Filter(Col("age").Lt(Lit(30))).
+			Collect()
+		require.NoError(t, err)
+		defer left.Release()
+
+		right, err := ReadCSV("../testdata/sample.csv").
+			Select("name", "age").
+			Filter(Col("age").Gt(Lit(27))).
+			Collect()
+		require.NoError(t, err)
+		defer right.Release()
+
+		result, err := left.Join(right, On("name").
+			WithType(JoinTypeOuter).
+			WithCoalesce(true).
+			WithIndicator(true)).
+			Sort([]string{"name"}).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: Diana and Frank appear on both sides
+		expected := `shape: (7, 4)
+┌─────────┬────────┬──────┬────────────┐
+│ name    ┆ salary ┆ age  ┆ _merge     │
+│ ---     ┆ ---    ┆ ---  ┆ ---        │
+│ str     ┆ i64    ┆ i64  ┆ str        │
+╞═════════╪════════╪══════╪════════════╡
+│ Alice   ┆ 50000  ┆ null ┆ left_only  │
+│ Bob     ┆ null   ┆ 30   ┆ right_only │
+│ Charlie ┆ null   ┆ 35   ┆ right_only │
+│ Diana   ┆ 55000  ┆ 28   ┆ both       │
+│ Eve     ┆ null   ┆ 32   ┆ right_only │
+│ Frank   ┆ 58000  ┆ 29   ┆ both       │
+│ Grace   ┆ 52000  ┆ null ┆ left_only  │
+└─────────┴────────┴──────┴────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("JoinKeyDtypeMismatch", func(t *testing.T) {
+		left, err := ReadCSV("../testdata/sample.csv").
+			Select("name", "salary").
+			Collect()
+		require.NoError(t, err)
+		defer left.Release()
+
+		right, err := ReadCSV("../testdata/sample.csv").
+			Select("name", "department").
+			Collect()
+		require.NoError(t, err)
+		defer right.Release()
+
+		// i64 salary cannot be matched against str name
+		_, err = left.Join(right, LeftOn("salary").RightOn("name")).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Join key dtype mismatch: left 'salary' is i64 but right 'name' is str")
+	})
 }
 
 // TestParquetOperations demonstrates Parquet file reading capabilities focused on Firn integration
@@ -1106,6 +4159,29 @@ func TestParquetOperations(t *testing.T) {
 		require.Equal(t, expected, result.String())
 	})
 
+	t.Run("ScanParquetIsLazy", func(t *testing.T) {
+		df := ScanParquet("../testdata/fortune1000_2024.parquet").
+			Select("Rank", "Company").
+			Filter(Col("Rank").Lt(Lit(100))).
+			Limit(3)
+		defer df.Release()
+		require.True(t, df.IsLazy())
+
+		result, err := df.Collect()
+		require.NoError(t, err)
+		require.False(t, result.IsLazy())
+		height, err := result.Height()
+		require.NoError(t, err)
+		require.Equal(t, 3, height)
+
+		csv := ScanCSV("../testdata/sample.csv")
+		defer csv.Release()
+		require.True(t, csv.IsLazy())
+		_, err = csv.Collect()
+		require.NoError(t, err)
+		require.False(t, csv.IsLazy())
+	})
+
 	t.Run("ParquetErrorHandling", func(t *testing.T) {
 		// Test error handling for invalid Parquet files
 		df := ReadParquet("../testdata/nonexistent.parquet")
@@ -1115,6 +4191,146 @@ func TestParquetOperations(t *testing.T) {
 	})
 }
 
+// TestJSONOperations demonstrates JSON and NDJSON ingestion
+func TestJSONOperations(t *testing.T) {
+	fromCSV, err := ReadCSV("../testdata/sample.csv").Collect()
+	require.NoError(t, err)
+	defer fromCSV.Release()
+
+	t.Run("ReadJSONArray", func(t *testing.T) {
+		result, err := ReadJSON("../testdata/sample.json").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: same rows and dtypes as the CSV source
+		require.Equal(t, fromCSV.String(), result.String())
+	})
+
+	t.Run("ReadNDJSONGlob", func(t *testing.T) {
+		result, err := ReadNDJSON("../testdata/ndjson/sample-*.ndjson").
+			Filter(Col("age").Gt(Lit(0))).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: parts are combined in path order
+		require.Equal(t, fromCSV.String(), result.String())
+	})
+
+	t.Run("NestedRecords", func(t *testing.T) {
+		// Each record holds a "user" object, read as a struct column
+		result, err := ReadNDJSON("../testdata/nested.ndjson").Unnest("user").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: the struct's fields replace it in place
+		expected := `shape: (3, 4)
+┌─────┬─────────┬────────┬───────┐
+│ id  ┆ name    ┆ city   ┆ score │
+│ --- ┆ ---     ┆ ---    ┆ ---   │
+│ i64 ┆ str     ┆ str    ┆ f64   │
+╞═════╪═════════╪════════╪═══════╡
+│ 1   ┆ Alice   ┆ Paris  ┆ 9.5   │
+│ 2   ┆ Bob     ┆ Berlin ┆ 7.25  │
+│ 3   ┆ Charlie ┆ Paris  ┆ 8.0   │
+└─────┴─────────┴────────┴───────┘`
+		require.Equal(t, expected, result.String())
+
+		result, err = ReadNDJSON("../testdata/nested.ndjson").
+			Filter(Col("user").StructField("city").Eq(Lit("Paris"))).
+			Select("id", Col("user").StructField("name")).
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		expected = `shape: (2, 2)
+┌─────┬─────────┐
+│ id  ┆ name    │
+│ --- ┆ ---     │
+│ i64 ┆ str     │
+╞═════╪═════════╡
+│ 1   ┆ Alice   │
+│ 3   ┆ Charlie │
+└─────┴─────────┘`
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadNDJSON("../testdata/nested.ndjson").Unnest().Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unnest() requires at least one column")
+
+		_, err = ReadNDJSON("../testdata/nested.ndjson").Select(Col("id").Cast(Struct)).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Struct has no field list")
+	})
+
+	t.Run("JSONOptions", func(t *testing.T) {
+		result, err := ReadNDJSONWithOptions("../testdata/ndjson/sample-1.ndjson", JSONOptions{
+			InferSchemaLength: -1,
+		}).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+		height, err := result.Height()
+		require.NoError(t, err)
+		require.Equal(t, 4, height)
+
+		// Without glob expansion the pattern is taken as a literal path
+		_, err = ReadNDJSONWithOptions("../testdata/ndjson/sample-*.ndjson", JSONOptions{}).Collect()
+		require.Error(t, err)
+	})
+
+	t.Run("JSONErrorHandling", func(t *testing.T) {
+		_, err := ReadJSON("../testdata/ndjson/none-*.json").Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no files match")
+
+		_, err = ReadNDJSON("../testdata/nonexistent.ndjson").Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "polars error")
+	})
+}
+
+// TestIPCOperations demonstrates Arrow IPC (Feather) round trips
+func TestIPCOperations(t *testing.T) {
+	t.Run("RoundTripPreservesDtypes", func(t *testing.T) {
+		original, err := ReadCSV("../testdata/sample.csv").
+			WithColumns(
+				Col("age").Cast(Int32),
+				Col("salary").Cast(Float32),
+				Col("age").Gt(Lit(30)).Alias("senior"),
+			).
+			Collect()
+		require.NoError(t, err)
+		defer original.Release()
+
+		dir := t.TempDir()
+		for _, compression := range []string{"", "lz4", "zstd"} {
+			path := filepath.Join(dir, "sample-"+compression+".arrow")
+			require.NoError(t, original.WriteIPC(path, compression))
+
+			roundTrip, err := ReadIPC(path).Collect()
+			require.NoError(t, err)
+
+			// Golden test: dtypes and values survive unchanged
+			require.Equal(t, original.String(), roundTrip.String(), compression)
+			roundTrip.Release()
+		}
+	})
+
+	t.Run("IPCErrorHandling", func(t *testing.T) {
+		original, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		defer original.Release()
+
+		err = original.WriteIPC(filepath.Join(t.TempDir(), "out.arrow"), "gzip")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `unknown compression "gzip"`)
+
+		_, err = ReadIPC("../testdata/nonexistent.arrow").Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "polars error")
+	})
+}
+
 // TestConditionalExpressions demonstrates When/Then/Otherwise functionality
 func TestConditionalExpressions(t *testing.T) {
 	t.Run("BasicConditional", func(t *testing.T) {
@@ -1326,6 +4542,135 @@ func TestErrorHandling(t *testing.T) {
 		require.Contains(t, err.Error(), "Call agg() first to resolve grouping")
 	})
 
+	t.Run("SharedHandleReleasedOnce", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		handle := result.handle.handle
+
+		shared, err := result.shareHandle()
+		require.NoError(t, err)
+		require.Equal(t, 2, handleRefCount(handle))
+
+		// Releasing one owner keeps the data alive for the other
+		require.NoError(t, shared.Release())
+		require.Equal(t, 1, handleRefCount(handle))
+		height, err := result.Height()
+		require.NoError(t, err)
+		require.Equal(t, 7, height)
+
+		// The last owner frees the handle; further releases are no-ops
+		require.NoError(t, result.Release())
+		require.Equal(t, 0, handleRefCount(handle))
+		require.NoError(t, result.Release())
+		require.NoError(t, shared.Release())
+
+		_, err = result.shareHandle()
+		require.Error(t, err)
+	})
+
+	t.Run("CloneBranches", func(t *testing.T) {
+		base, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		handle := base.handle.handle
+
+		// Two pipelines branch off the same collected data
+		seniors, err := base.Clone().Filter(Col("age").Gt(Lit(30))).Select("name", "age").Collect()
+		require.NoError(t, err)
+		defer seniors.Release()
+		tops, err := base.Clone().
+			GroupBy("department").
+			Agg(Col("salary").Max().Alias("top_salary")).
+			Sort([]string{"department"}).
+			Collect()
+		require.NoError(t, err)
+		defer tops.Release()
+
+		expected := `shape: (2, 2)
+┌─────────┬─────┐
+│ name    ┆ age │
+│ ---     ┆ --- │
+│ str     ┆ i64 │
+╞═════════╪═════╡
+│ Charlie ┆ 35  │
+│ Eve     ┆ 32  │
+└─────────┴─────┘`
+		require.Equal(t, expected, seniors.String())
+
+		expected = `shape: (3, 2)
+┌─────────────┬────────────┐
+│ department  ┆ top_salary │
+│ ---         ┆ ---        │
+│ str         ┆ i64        │
+╞═════════════╪════════════╡
+│ Engineering ┆ 70000      │
+│ Marketing   ┆ 60000      │
+│ Sales       ┆ 55000      │
+└─────────────┴────────────┘`
+		require.Equal(t, expected, tops.String())
+
+		// Collected branches gave up their share; releasing a clone leaves base intact
+		require.Equal(t, 1, handleRefCount(handle))
+		clone := base.Clone()
+		require.Equal(t, 2, handleRefCount(handle))
+		require.NoError(t, clone.Release())
+		height, err := base.Height()
+		require.NoError(t, err)
+		require.Equal(t, 7, height)
+		require.NoError(t, base.Release())
+		require.Equal(t, 0, handleRefCount(handle))
+
+		_, err = base.Clone().Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Clone: dataframe not executed")
+
+		_, err = ReadCSV("../testdata/sample.csv").Clone().Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "pending operations")
+	})
+
+	t.Run("ErrorNamesBuilderCall", func(t *testing.T) {
+		// Validation error raised while building the chain
+		_, err := ReadCSV("../testdata/sample.csv").
+			Select("name", "salary").
+			Sort(nil).
+			Limit(3).
+			Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "at Sort() [call 2, operation")
+
+		var polarsErr *Error
+		require.ErrorAs(t, err, &polarsErr)
+		require.Equal(t, "Sort()", polarsErr.Source)
+
+		// Error reported by the Rust side for a specific operation
+		_, err = ReadCSV("../testdata/sample.csv").
+			Filter(Col("age").Gt(Lit(30))).
+			Agg(Col("salary").Mean()).
+			Collect()
+		require.Error(t, err)
+		require.ErrorAs(t, err, &polarsErr)
+		require.Equal(t, "Agg()", polarsErr.Source)
+		require.Contains(t, err.Error(), "at Agg() [call 2, operation")
+	})
+
+	t.Run("UserFrameCountsBuilderCalls", func(t *testing.T) {
+		// Builder calls: ReadCSV (0), WithColumns (1), Select (2), Select with an invalid Lag (3), Limit (4)
+		_, err := ReadCSV("../testdata/sample.csv").
+			WithColumns(Col("salary").Mul(Lit(2)).Alias("double_salary")).
+			Select("name", "double_salary").
+			Select(Col("double_salary").Lag(0)).
+			Limit(3).
+			Collect()
+		require.Error(t, err)
+
+		var polarsErr *Error
+		require.ErrorAs(t, err, &polarsErr)
+		require.Equal(t, "Select()", polarsErr.Source)
+		require.Equal(t, 3, polarsErr.UserFrame)
+		// The raw frame also counts every expression op pushed by the earlier calls
+		require.Greater(t, polarsErr.Frame, polarsErr.UserFrame)
+	})
+
 	t.Run("InvalidParquetFile", func(t *testing.T) {
 		df := ReadParquet("../testdata/nonexistent.parquet")
 		_, err := df.Collect()
@@ -1333,3 +4678,44 @@ func TestErrorHandling(t *testing.T) {
 		require.Contains(t, err.Error(), "polars error")
 	})
 }
+
+// BenchmarkQuantiles compares one Quantiles aggregation with three separate Quantile calls
+// over 1M rows in 100 groups
+func BenchmarkQuantiles(b *testing.B) {
+	const rows = 1_000_000
+	groups := make([]any, rows)
+	values := make([]any, rows)
+	for i := range rows {
+		groups[i] = i % 100
+		values[i] = float64(i*7919%10007) / 10
+	}
+	base, err := FromColumns(map[string][]any{"group": groups, "value": values})
+	require.NoError(b, err)
+	defer base.Release()
+
+	b.Run("Quantiles", func(b *testing.B) {
+		for b.Loop() {
+			result, err := base.Clone().
+				GroupBy("group").
+				Agg(Col("value").Quantiles([]float64{0.5, 0.9, 0.99}, QuantileLinear)).
+				Collect()
+			require.NoError(b, err)
+			result.Release()
+		}
+	})
+
+	b.Run("ThreeQuantileCalls", func(b *testing.B) {
+		for b.Loop() {
+			result, err := base.Clone().
+				GroupBy("group").
+				Agg(
+					Col("value").Quantile(0.5, QuantileLinear).Alias("value_p50"),
+					Col("value").Quantile(0.9, QuantileLinear).Alias("value_p90"),
+					Col("value").Quantile(0.99, QuantileLinear).Alias("value_p99"),
+				).
+				Collect()
+			require.NoError(b, err)
+			result.Release()
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package polars
+
+/*
+#include "firn.h"
+*/
+import "C"
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// DatasetOptions configures reading a mixed-format dataset
+type DatasetOptions struct {
+	Recursive bool           // Descend into subdirectories when path is a directory
+	CSV       *CSVReadConfig // CSV options (nil = header row, ',' separator); row skips and NRows apply per file
+}
+
+// ReadDataset creates a DataFrame from every CSV and Parquet file under a directory or glob
+// Formats are detected by extension (.csv, .parquet) and files are combined in path order
+// with a diagonal concat, so columns missing from some files are filled with nulls.
+// Example: ReadDataset("lake/events", DatasetOptions{Recursive: true})
+func ReadDataset(path string, opts DatasetOptions) *DataFrame {
+	files, err := datasetFiles(path, opts.Recursive)
+	if err != nil {
+		return (&DataFrame{}).appendErrOpf("ReadDataset: %v", err)
+	}
+	if len(files) == 0 {
+		return (&DataFrame{}).appendErrOpf("ReadDataset: no CSV or Parquet files found under %s", path)
+	}
+
+	csv := CSVReadConfig{HasHeader: true}
+	if opts.CSV != nil {
+		csv = *opts.CSV
+	}
+	if err := csv.validate(); err != nil {
+		return (&DataFrame{}).appendErrOpf("ReadDataset: %v", err)
+	}
+
+	op := Operation{
+		opcode: OpReadDataset,
+		source: "ReadDataset()",
+		args: func() unsafe.Pointer {
+			paths := make([]string, len(files))
+			formats := make([]C.uint8_t, len(files))
+			for i, file := range files {
+				paths[i] = file.path
+				formats[i] = C.uint8_t(file.format)
+			}
+			pathsPtr, count := makeRawStrArray(paths)
+
+			return unsafe.Pointer(&C.ReadDatasetArgs{
+				paths:   pathsPtr,
+				formats: &formats[0],
+				count:   count,
+				csv:     csv.csvArgs(),
+			})
+		},
+	}
+
+	return &DataFrame{
+		handle:     C.PolarsHandle{handle: C.uintptr_t(0), context_type: C.uint32_t(0)}, // Lazy - no handle yet
+		operations: []Operation{op},
+	}
+}
+
+// Dataset member formats, matching DATASET_FORMAT_* in firn.h
+const (
+	datasetFormatCSV     = C.DATASET_FORMAT_CSV
+	datasetFormatParquet = C.DATASET_FORMAT_PARQUET
+)
+
+// datasetFile is a discovered dataset member with its detected format
+type datasetFile struct {
+	path   string
+	format int
+}
+
+// datasetFormat detects a file format from its extension; ok is false for other files
+func datasetFormat(path string) (format int, ok bool) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return datasetFormatCSV, true
+	case ".parquet":
+		return datasetFormatParquet, true
+	default:
+		return 0, false
+	}
+}
+
+// datasetFiles lists the CSV and Parquet files under a directory or matching a glob, sorted by path
+func datasetFiles(path string, recursive bool) ([]datasetFile, error) {
+	var paths []string
+
+	info, err := os.Stat(path)
+	switch {
+	case err == nil && info.IsDir():
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return walkErr
+			}
+			if d.IsDir() {
+				if p != path && !recursive {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			paths = append(paths, p)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	case err == nil:
+		paths = []string{path}
+	default:
+		// Not an existing file or directory - treat the path as a glob pattern
+		paths, err = filepath.Glob(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(paths)
+	var files []datasetFile
+	for _, p := range paths {
+		if format, ok := datasetFormat(p); ok {
+			files = append(files, datasetFile{path: p, format: format})
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,50 @@
+package polars
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDatasetOperations demonstrates reading mixed-format datasets
+func TestDatasetOperations(t *testing.T) {
+	t.Run("MixedCSVAndParquet", func(t *testing.T) {
+		// employees_part1.csv holds Alice..Diana, employees_part2.parquet holds Eve..Grace
+		df := ReadDataset("../testdata/dataset", DatasetOptions{})
+		result, err := df.Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: both files combine into the full sample dataset
+		expected := `shape: (7, 4)
+┌─────────┬─────┬────────┬─────────────┐
+│ name    ┆ age ┆ salary ┆ department  │
+│ ---     ┆ --- ┆ ---    ┆ ---         │
+│ str     ┆ i64 ┆ i64    ┆ str         │
+╞═════════╪═════╪════════╪═════════════╡
+│ Alice   ┆ 25  ┆ 50000  ┆ Engineering │
+│ Bob     ┆ 30  ┆ 60000  ┆ Marketing   │
+│ Charlie ┆ 35  ┆ 70000  ┆ Engineering │
+│ Diana   ┆ 28  ┆ 55000  ┆ Sales       │
+│ Eve     ┆ 32  ┆ 65000  ┆ Engineering │
+│ Frank   ┆ 29  ┆ 58000  ┆ Marketing   │
+│ Grace   ┆ 27  ┆ 52000  ┆ Sales       │
+└─────────┴─────┴────────┴─────────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("GlobSelectsFormats", func(t *testing.T) {
+		files, err := datasetFiles("../testdata/dataset/*.parquet", false)
+		require.NoError(t, err)
+		require.Equal(t, []datasetFile{
+			{path: "../testdata/dataset/employees_part2.parquet", format: datasetFormatParquet},
+		}, files)
+	})
+
+	t.Run("NoMatchingFiles", func(t *testing.T) {
+		_, err := ReadDataset("../testdata/dataset/*.json", DatasetOptions{}).Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no CSV or Parquet files found")
+	})
+}
@@ -7,6 +7,9 @@ import "C"
 import (
 	"fmt"
 	"iter"
+	"math"
+	"regexp"
+	"strings"
 	"unsafe"
 )
 
@@ -91,6 +94,90 @@ func Col(name string) *ExprNode {
 	}
 }
 
+// Cols selects every column whose name matches a pattern; Polars expands it against the schema
+// A pattern starting with '^' is a regex ("^temp_" selects names starting with temp_; the
+// trailing '$' Polars requires is optional), a pattern containing '*' is a wildcard
+// ("sensor_*", or "*" for all columns), and anything else is a single column name as with Col.
+// Example: df.SelectExpr(Cols("^sensor_").Mean()) averages every sensor column
+func Cols(pattern string) *ExprNode {
+	switch {
+	case pattern == "*":
+		return Col(pattern) // Polars treats col("*") as every column
+	case strings.HasPrefix(pattern, "^"):
+		if _, err := regexp.Compile(pattern); err != nil {
+			return &ExprNode{ops: single(errOpf("Cols() invalid regex %q: %v", pattern, err))}
+		}
+		// Polars expands a column name as a regex only when it is wrapped in ^...$
+		if !strings.HasSuffix(pattern, "$") {
+			pattern += ".*$"
+		}
+		return Col(pattern)
+	case strings.Contains(pattern, "*"):
+		parts := strings.Split(pattern, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		return Col("^" + strings.Join(parts, ".*") + "$")
+	default:
+		return Col(pattern)
+	}
+}
+
+// AllCols selects every column, e.g. df.SelectExpr(AllCols().Count())
+func AllCols() *ExprNode {
+	return Col("*")
+}
+
+// dtypeCols selects every column whose data type the selector matches
+func dtypeCols(selector Selector) *ExprNode {
+	return &ExprNode{
+		ops: single(Operation{
+			opcode: OpExprDtypeCols,
+			args: func() unsafe.Pointer {
+				dtypes, count := makeDtypeArray(selector.dtypes) // selector captured by closure
+				return unsafe.Pointer(&C.DtypeColsArgs{
+					dtypes: dtypes,
+					count:  count,
+				})
+			},
+		}),
+	}
+}
+
+// Exclude removes the named columns from a multi-column selector such as AllCols() or Cols()
+// Example: df.SelectExpr(AllCols().Exclude("internal_id"))
+func (expr *ExprNode) Exclude(columns ...string) *ExprNode {
+	if len(columns) == 0 {
+		return &ExprNode{ops: combine(expr.ops, single(errOp("Exclude() requires at least one column")))}
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprExclude,
+			args: func() unsafe.Pointer {
+				names, count := makeRawStrArray(columns) // columns captured by closure
+				return unsafe.Pointer(&C.ExcludeArgs{
+					columns: names,
+					count:   count,
+				})
+			},
+		})),
+	}
+}
+
+// Len counts the rows of the frame or of each group as u32, nulls included (SQL COUNT(*))
+// Unlike Col(name).Count(), the result does not depend on nulls in any column. The output
+// column is named "len".
+// Example: df.GroupBy("department").Agg(Len().Alias("n"))
+func Len() *ExprNode {
+	return &ExprNode{
+		ops: single(Operation{
+			opcode: OpExprLen,
+			args:   noArgs,
+		}),
+	}
+}
+
 func Lit(value interface{}) *ExprNode {
 	return &ExprNode{
 		ops: func(yield func(Operation) bool) {
@@ -206,6 +293,92 @@ func (left *ExprNode) Eq(right *ExprNode) *ExprNode {
 	return binOp(left, right, OpExprEq)
 }
 
+func (left *ExprNode) Ge(right *ExprNode) *ExprNode {
+	return binOp(left, right, OpExprGe)
+}
+
+func (left *ExprNode) Le(right *ExprNode) *ExprNode {
+	return binOp(left, right, OpExprLe)
+}
+
+func (left *ExprNode) Ne(right *ExprNode) *ExprNode {
+	return binOp(left, right, OpExprNe)
+}
+
+// Between tests lower <= expr <= upper (lower < expr < upper when inclusive is false)
+// The bounds may be any expressions, including other columns.
+// Usage: df.Filter(Col("salary").Between(Lit(50000), Lit(65000), true))
+func (expr *ExprNode) Between(lower, upper *ExprNode, inclusive bool) *ExprNode {
+	lowerOp, upperOp := uint32(OpExprGt), uint32(OpExprLt)
+	if inclusive {
+		lowerOp, upperOp = OpExprGe, OpExprLe
+	}
+	// The expression is emitted twice, once for each bound comparison
+	ops := expr.consumeOps()
+	expr.ops = combine(
+		ops, lower.consumeOps(), single(Operation{opcode: lowerOp, args: noArgs}),
+		ops, upper.consumeOps(), single(Operation{opcode: upperOp, args: noArgs}),
+		single(Operation{opcode: OpExprAnd, args: noArgs}))
+	return expr
+}
+
+// IsIn tests whether the expression's value is one of the given literals
+// Values must be all ints, all floats or all strings. The set is hashed once by Polars,
+// so this is much cheaper than an equivalent chain of Eq(...).Or(...) for large sets.
+// Usage: df.Filter(Col("department").IsIn("Engineering", "Sales"))
+func (expr *ExprNode) IsIn(values ...any) *ExprNode {
+	fail := func(format string, args ...any) *ExprNode {
+		return &ExprNode{ops: combine(expr.ops, single(errOpf(format, args...)))}
+	}
+	if len(values) == 0 {
+		return fail("IsIn() requires at least one value")
+	}
+	kind := -1
+	for _, value := range values {
+		var valueKind int
+		switch value.(type) {
+		case int, int64:
+			valueKind = 0
+		case float64:
+			valueKind = 1
+		case string:
+			valueKind = 2
+		default:
+			return fail("IsIn() unsupported value type %T (expected int, float64 or string)", value)
+		}
+		if kind >= 0 && valueKind != kind {
+			return fail("IsIn() values mix %T and %T", values[0], value)
+		}
+		kind = valueKind
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprIsIn,
+			args: func() unsafe.Pointer {
+				// Closure captures values, keeping string data alive
+				literals := make([]C.Literal, len(values))
+				for i, value := range values {
+					switch v := value.(type) {
+					case int:
+						literals[i] = C.Literal{value_type: 0, int_value: C.longlong(v)}
+					case int64:
+						literals[i] = C.Literal{value_type: 0, int_value: C.longlong(v)}
+					case float64:
+						literals[i] = C.Literal{value_type: 1, float_value: C.double(v)}
+					case string:
+						literals[i] = C.Literal{value_type: 2, string_value: makeRawStr(v)}
+					}
+				}
+				return unsafe.Pointer(&C.IsInArgs{
+					values: &literals[0],
+					count:  C.size_t(len(literals)),
+				})
+			},
+		})),
+	}
+}
+
 // Arithmetic operations
 func (left *ExprNode) Add(right *ExprNode) *ExprNode {
 	return binOp(left, right, OpExprAdd)
@@ -223,6 +396,22 @@ func (left *ExprNode) Div(right *ExprNode) *ExprNode {
 	return binOp(left, right, OpExprDiv)
 }
 
+// Mod returns the remainder of left divided by right
+// Integer operands give an integer result and a zero divisor yields null; float operands
+// give a float result and a zero divisor yields NaN. As in Polars (and Python), a nonzero
+// result takes the sign of the divisor: Lit(-7).Mod(Lit(3)) is 2.
+// Usage: df.Filter(Col("id").Mod(Lit(10)).Eq(Lit(0)))
+func (left *ExprNode) Mod(right *ExprNode) *ExprNode {
+	return binOp(left, right, OpExprMod)
+}
+
+// DotProduct multiplies a and b elementwise and sums the products into a scalar
+// Inside Agg() the reduction is computed per group.
+// Example: DotProduct(Col("weight"), Col("score")).Alias("weighted_score")
+func DotProduct(a, b *ExprNode) *ExprNode {
+	return binOp(a, b, OpExprDot)
+}
+
 // Boolean operations
 func (left *ExprNode) And(right *ExprNode) *ExprNode {
 	return binOp(left, right, OpExprAnd)
@@ -241,6 +430,14 @@ func (expr *ExprNode) Sum() *ExprNode {
 	return expr.unaryOp(OpExprSum)
 }
 
+// Product multiplies all values of the expression (1 for an empty input)
+// Integers narrower than 64 bits are promoted to Int64 (UInt64 stays UInt64) and 64-bit
+// products wrap on overflow, as in Polars; floats keep their type.
+// Usage: Col("growth_factor").Product().Over("portfolio")
+func (expr *ExprNode) Product() *ExprNode {
+	return expr.unaryOp(OpExprProduct)
+}
+
 // Mean applies mean aggregation to the expression
 func (expr *ExprNode) Mean() *ExprNode {
 	return expr.unaryOp(OpExprMean)
@@ -261,6 +458,17 @@ func (expr *ExprNode) Median() *ExprNode {
 	return expr.unaryOp(OpExprMedian)
 }
 
+// ArgMax returns the u32 row index of the maximum value within the group or frame
+// Usage: df.GroupBy("department").Agg(Col("salary").ArgMax().Alias("top_earner_idx"))
+func (expr *ExprNode) ArgMax() *ExprNode {
+	return expr.unaryOp(OpExprArgMax)
+}
+
+// ArgMin returns the u32 row index of the minimum value within the group or frame
+func (expr *ExprNode) ArgMin() *ExprNode {
+	return expr.unaryOp(OpExprArgMin)
+}
+
 // First gets the first value of the expression
 func (expr *ExprNode) First() *ExprNode {
 	return expr.unaryOp(OpExprFirst)
@@ -286,6 +494,190 @@ func (expr *ExprNode) IsNotNull() *ExprNode {
 	return expr.unaryOp(OpExprIsNotNull)
 }
 
+// IsNaN checks if float values are NaN (null values stay null)
+func (expr *ExprNode) IsNaN() *ExprNode {
+	return expr.unaryOp(OpExprIsNaN)
+}
+
+// IsNotNaN checks if float values are not NaN (null values stay null)
+func (expr *ExprNode) IsNotNaN() *ExprNode {
+	return expr.unaryOp(OpExprIsNotNaN)
+}
+
+// IsFinite checks if float values are neither infinite nor NaN
+// Usage: df.Filter(Col("ratio").IsFinite())
+func (expr *ExprNode) IsFinite() *ExprNode {
+	return expr.unaryOp(OpExprIsFinite)
+}
+
+// IsInfinite checks if float values are +inf or -inf
+func (expr *ExprNode) IsInfinite() *ExprNode {
+	return expr.unaryOp(OpExprIsInfinite)
+}
+
+// IsUnique checks if each value appears exactly once in the column (or Over() partition)
+// Usage: df.Filter(Col("email").IsUnique())
+func (expr *ExprNode) IsUnique() *ExprNode {
+	return expr.unaryOp(OpExprIsUnique)
+}
+
+// IsDuplicated checks if each value appears more than once in the column (or Over() partition)
+func (expr *ExprNode) IsDuplicated() *ExprNode {
+	return expr.unaryOp(OpExprIsDuplicated)
+}
+
+// FillNull replaces nulls with the value of another expression (a literal or a column)
+// Usage: Col("salary").FillNull(Lit(0)) or Col("nickname").FillNull(Col("name"))
+func (expr *ExprNode) FillNull(value *ExprNode) *ExprNode {
+	return binOp(expr, value, OpExprFillNull)
+}
+
+// ForwardFill replaces nulls with the previous non-null value
+// limit caps how many consecutive nulls are filled (limit <= 0 means unlimited).
+// Combine with Over() to fill within each partition.
+// Usage: Col("reading").ForwardFill(0).Over("sensor_id")
+func (expr *ExprNode) ForwardFill(limit int) *ExprNode {
+	return expr.fillStrategy(OpExprForwardFill, limit)
+}
+
+// BackwardFill replaces nulls with the next non-null value
+// limit caps how many consecutive nulls are filled (limit <= 0 means unlimited).
+// Usage: Col("reading").BackwardFill(2).Over("sensor_id")
+func (expr *ExprNode) BackwardFill(limit int) *ExprNode {
+	return expr.fillStrategy(OpExprBackwardFill, limit)
+}
+
+// Interpolate fills nulls linearly between the surrounding non-null values; integer
+// columns become f64. Leading and trailing nulls stay null, and Over() keeps each
+// partition's gaps from being bridged by a neighbouring series.
+// Usage: Col("reading").Interpolate().Over("sensor_id")
+func (expr *ExprNode) Interpolate() *ExprNode {
+	return expr.unaryOp(OpExprInterpolate)
+}
+
+// Coalesce returns, per row, the first non-null value among exprs (tried in order)
+// The inputs are cast to their common supertype; a row where all are null stays null.
+// Usage: Coalesce(Col("name_left"), Col("name_right")).Alias("name")
+func Coalesce(exprs ...*ExprNode) *ExprNode {
+	return naryOp("Coalesce", exprs, Operation{
+		opcode: OpExprCoalesce,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.CoalesceArgs{
+				count: C.size_t(len(exprs)),
+			})
+		},
+	})
+}
+
+// naryOp pushes every input expression in order, then op, which consumes them all
+func naryOp(method string, exprs []*ExprNode, op Operation) *ExprNode {
+	if len(exprs) == 0 {
+		return &ExprNode{ops: single(errOpf("%s() requires at least one expression", method))}
+	}
+	for i, expr := range exprs {
+		if expr == nil || expr.consumed() {
+			return &ExprNode{ops: single(errOpf("%s(): expression %d is nil or already used", method, i))}
+		}
+	}
+
+	ops := make([]iter.Seq[Operation], 0, len(exprs)+1)
+	for _, expr := range exprs {
+		ops = append(ops, expr.consumeOps())
+	}
+	ops = append(ops, single(op))
+	return &ExprNode{ops: combine(ops...)}
+}
+
+// fillStrategy appends a forward/backward fill operation with a fill limit
+func (expr *ExprNode) fillStrategy(opcode uint32, limit int) *ExprNode {
+	if limit < 0 {
+		limit = 0 // Unlimited
+	}
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: opcode,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.FillArgs{
+					limit: C.uint32_t(limit),
+				})
+			},
+		})),
+	}
+}
+
+// Shift moves values down by n rows (up for negative n); the vacated rows become null
+// Unlike Lag/Lead it needs no window ordering and works directly in WithColumns.
+// Usage: Col("price").Shift(1).Alias("prev_price")
+func (expr *ExprNode) Shift(n int) *ExprNode {
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprShift,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.ShiftArgs{n: C.int64_t(n)})
+			},
+		})),
+	}
+}
+
+// Diff computes the difference from n rows earlier: x[i] - x[i-n] (null for the first n rows)
+// Usage: Col("price").Diff(1).Alias("delta")
+func (expr *ExprNode) Diff(n int) *ExprNode {
+	// The expression is emitted twice: once as is and once shifted
+	ops := expr.consumeOps()
+	return binOp(&ExprNode{ops: ops}, (&ExprNode{ops: ops}).Shift(n), OpExprSub)
+}
+
+// PctChange computes the fractional change from n rows earlier: (x[i] - x[i-n]) / x[i-n]
+// The result is Float64 and null for the first n rows; a zero denominator yields inf
+// (or NaN when the change is also zero). Nulls are forward filled before comparing.
+// Usage: Col("close").PctChange(1).Over("ticker").Alias("return")
+func (expr *ExprNode) PctChange(n int) *ExprNode {
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprPctChange,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.ShiftArgs{n: C.int64_t(n)})
+			},
+		})),
+	}
+}
+
+// Cumulative operations keep one value per row, so they belong in WithColumns/SelectExpr;
+// combine with Over() for per-partition running values. reverse accumulates from the last row.
+
+// Cumsum computes the running sum
+// Usage: Col("sales").Cumsum(false).Over("region").Alias("running_total")
+func (expr *ExprNode) Cumsum(reverse bool) *ExprNode {
+	return expr.cumulative(OpExprCumsum, reverse)
+}
+
+// Cummax computes the running maximum
+func (expr *ExprNode) Cummax(reverse bool) *ExprNode {
+	return expr.cumulative(OpExprCummax, reverse)
+}
+
+// Cummin computes the running minimum
+func (expr *ExprNode) Cummin(reverse bool) *ExprNode {
+	return expr.cumulative(OpExprCummin, reverse)
+}
+
+// Cumprod computes the running product
+func (expr *ExprNode) Cumprod(reverse bool) *ExprNode {
+	return expr.cumulative(OpExprCumprod, reverse)
+}
+
+// cumulative appends a cumulative operation with its direction
+func (expr *ExprNode) cumulative(opcode uint32, reverse bool) *ExprNode {
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: opcode,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.CumulativeArgs{reverse: C.bool(reverse)})
+			},
+		})),
+	}
+}
+
 // Count counts non-null values (excludes nulls)
 func (expr *ExprNode) Count() *ExprNode {
 	return &ExprNode{
@@ -300,6 +692,12 @@ func (expr *ExprNode) Count() *ExprNode {
 	}
 }
 
+// NullCount counts null values as u32 (per group inside Agg)
+// Example: df.GroupBy("sensor").Agg(Col("reading").NullCount().Alias("missing"))
+func (expr *ExprNode) NullCount() *ExprNode {
+	return expr.unaryOp(OpExprNullCount)
+}
+
 // CountWithNulls counts all values including nulls
 func (expr *ExprNode) CountWithNulls() *ExprNode {
 	return &ExprNode{
@@ -390,13 +788,302 @@ func (expr *ExprNode) Var(ddof ...uint8) *ExprNode {
 	return expr.ddofAggregation(OpExprVar, "Var", ddof...)
 }
 
+// momentOp is a helper for skew/kurtosis operations that take MomentArgs
+func (expr *ExprNode) momentOp(opcode uint32, fisher, bias bool) *ExprNode {
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: opcode,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.MomentArgs{fisher: C.bool(fisher), bias: C.bool(bias)})
+			},
+		})),
+	}
+}
+
+// Skew computes the sample skewness of the expression as a Float64 aggregation
+// bias=false applies the sample-size correction; non-numeric input fails at collect time.
+// Usage: Col("high_temp").Skew(true).Alias("temp_skew")
+func (expr *ExprNode) Skew(bias bool) *ExprNode {
+	return expr.momentOp(OpExprSkew, false, bias)
+}
+
+// Kurtosis computes the kurtosis of the expression as a Float64 aggregation
+// fisher=true reports excess kurtosis (normal = 0.0), fisher=false Pearson's (normal = 3.0).
+// Usage: Col("high_temp").Kurtosis(true, true).Alias("temp_kurtosis")
+func (expr *ExprNode) Kurtosis(fisher, bias bool) *ExprNode {
+	return expr.momentOp(OpExprKurtosis, fisher, bias)
+}
+
+// QuantileInterp selects how quantiles that fall between two values are interpolated
+type QuantileInterp int
+
+const (
+	QuantileNearest  QuantileInterp = C.QUANTILE_INTERP_NEAREST
+	QuantileLower    QuantileInterp = C.QUANTILE_INTERP_LOWER
+	QuantileHigher   QuantileInterp = C.QUANTILE_INTERP_HIGHER
+	QuantileMidpoint QuantileInterp = C.QUANTILE_INTERP_MIDPOINT
+	QuantileLinear   QuantileInterp = C.QUANTILE_INTERP_LINEAR
+)
+
+// ParseQuantileInterp maps a Polars interpolation name to its QuantileInterp
+// Accepted names: "nearest", "linear", "lower", "higher" and "midpoint".
+// Usage: interp, err := ParseQuantileInterp("linear"); Col("salary").Quantile(0.95, interp)
+func ParseQuantileInterp(name string) (QuantileInterp, error) {
+	switch name {
+	case "nearest":
+		return QuantileNearest, nil
+	case "linear":
+		return QuantileLinear, nil
+	case "lower":
+		return QuantileLower, nil
+	case "higher":
+		return QuantileHigher, nil
+	case "midpoint":
+		return QuantileMidpoint, nil
+	default:
+		return 0, fmt.Errorf("unknown quantile interpolation %q (want nearest, linear, lower, higher or midpoint)", name)
+	}
+}
+
+// quantileOp is a helper for quantile operations that take QuantileArgs
+func (expr *ExprNode) quantileOp(opcode uint32, opName string, qs []float64, interp QuantileInterp) *ExprNode {
+	if len(qs) == 0 {
+		return &ExprNode{ops: combine(expr.ops, single(errOpf("%s() requires at least one quantile", opName)))}
+	}
+	for _, q := range qs {
+		if q < 0 || q > 1 {
+			return &ExprNode{ops: combine(expr.ops, single(errOpf("%s() quantile %v is outside [0, 1]", opName, q)))}
+		}
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: opcode,
+			args: func() unsafe.Pointer {
+				cQuantiles := make([]C.double, len(qs))
+				for i, q := range qs {
+					cQuantiles[i] = C.double(q)
+				}
+				return unsafe.Pointer(&C.QuantileArgs{
+					quantiles: &cQuantiles[0],
+					count:     C.size_t(len(qs)),
+					interp:    C.uint32_t(interp),
+				})
+			},
+		})),
+	}
+}
+
+// Quantile applies a quantile aggregation to the expression
+// Usage: Col("latency").Quantile(0.99, QuantileLinear)
+func (expr *ExprNode) Quantile(q float64, interp QuantileInterp) *ExprNode {
+	return expr.quantileOp(OpExprQuantile, "Quantile", []float64{q}, interp)
+}
+
+// Quantiles computes several quantiles of the expression as one struct column named
+// "<name>_quantiles", with a field per quantile named "<name>_p<percent>" (e.g. salary_p50,
+// salary_p99_9). Unnest the struct to get one column per quantile.
+// Quantiles is a packaging convenience: each quantile is still computed on its own, so it
+// is no faster than the equivalent Quantile calls (see BenchmarkQuantiles).
+// Usage: df.GroupBy("service").Agg(Col("latency").Quantiles([]float64{0.5, 0.9, 0.99}, QuantileLinear)).
+// Unnest("latency_quantiles")
+func (expr *ExprNode) Quantiles(qs []float64, interp QuantileInterp) *ExprNode {
+	return expr.quantileOp(OpExprQuantiles, "Quantiles", qs, interp)
+}
+
+// ClipQuantile clamps values to the expression's own lower and upper quantiles (winsorization)
+// Bounds use linear interpolation and the result is Float64; inside Agg they are per group.
+// Usage: Col("x").ClipQuantile(0.01, 0.99)
+func (expr *ExprNode) ClipQuantile(lower, upper float64) *ExprNode {
+	if lower > upper {
+		return &ExprNode{ops: combine(expr.ops, single(errOpf("ClipQuantile() lower quantile %v exceeds upper %v", lower, upper)))}
+	}
+	return expr.quantileOp(OpExprClipQuantile, "ClipQuantile", []float64{lower, upper}, QuantileLinear)
+}
+
+// WeightedMean computes sum(expr * weights) / sum(weights) as a Float64 aggregation
+// Rows with a null value or weight are skipped; a zero total weight yields null.
+// Usage: df.GroupBy("ticker").Agg(Col("price").WeightedMean(Col("volume")).Alias("vwap"))
+func (expr *ExprNode) WeightedMean(weights *ExprNode) *ExprNode {
+	return binOp(expr, weights, OpExprWeightedMean)
+}
+
+// Abs returns the absolute value of each element
+func (expr *ExprNode) Abs() *ExprNode {
+	return expr.unaryOp(OpExprAbs)
+}
+
+// Round rounds floats to the given number of decimals; integer columns are left unchanged
+// Usage: Col("salary").Div(Col("age")).Round(2).Alias("per_age")
+func (expr *ExprNode) Round(decimals int) *ExprNode {
+	if decimals < 0 {
+		return &ExprNode{ops: combine(expr.ops, single(errOpf("Round() decimals must be >= 0, got %d", decimals)))}
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprRound,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.RoundArgs{decimals: C.uint32_t(decimals)})
+			},
+		})),
+	}
+}
+
+// Floor rounds each element down to the nearest integer value
+func (expr *ExprNode) Floor() *ExprNode {
+	return expr.unaryOp(OpExprFloor)
+}
+
+// Ceil rounds each element up to the nearest integer value
+func (expr *ExprNode) Ceil() *ExprNode {
+	return expr.unaryOp(OpExprCeil)
+}
+
+// Pow raises each element to the power of the exponent expression
+// Usage: Col("x").Pow(Lit(2.0))
+func (expr *ExprNode) Pow(exponent *ExprNode) *ExprNode {
+	return binOp(expr, exponent, OpExprPow)
+}
+
+// Sqrt returns the square root of each element as Float64
+func (expr *ExprNode) Sqrt() *ExprNode {
+	return expr.unaryOp(OpExprSqrt)
+}
+
+// Exp returns e raised to the power of each element as Float64
+func (expr *ExprNode) Exp() *ExprNode {
+	return expr.unaryOp(OpExprExp)
+}
+
+// Log returns the logarithm of each element in the given base as Float64
+// Usage: Col("bytes").Log(2)
+func (expr *ExprNode) Log(base float64) *ExprNode {
+	if base <= 0 || base == 1 {
+		return &ExprNode{ops: combine(expr.ops, single(errOpf("Log() base must be positive and not 1, got %v", base)))}
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprLog,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.LogArgs{base: C.double(base)})
+			},
+		})),
+	}
+}
+
+// Log10 returns the base-10 logarithm of each element as Float64
+func (expr *ExprNode) Log10() *ExprNode {
+	return expr.Log(10)
+}
+
+// Ln returns the natural logarithm of each element as Float64
+func (expr *ExprNode) Ln() *ExprNode {
+	return expr.Log(math.E)
+}
+
+// Clip bounds values to [lower, upper]; pass nil for an unbounded side
+// The bounds may be literals or other columns and are cast to the input type, so
+// integer columns stay integers.
+// Usage: Col("temp").Clip(Lit(-50.0), Lit(50.0))
+func (expr *ExprNode) Clip(lower, upper *ExprNode) *ExprNode {
+	if lower == nil && upper == nil {
+		return &ExprNode{ops: combine(expr.ops, single(errOp("Clip() requires at least one bound")))}
+	}
+
+	var lowerOps, upperOps iter.Seq[Operation]
+	if lower != nil {
+		lowerOps = lower.consumeOps()
+	}
+	if upper != nil {
+		upperOps = upper.consumeOps()
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, lowerOps, upperOps, single(Operation{
+			opcode: OpExprClip,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.ClipArgs{
+					has_lower: C.bool(lower != nil),
+					has_upper: C.bool(upper != nil),
+				})
+			},
+		})),
+	}
+}
+
+// ClipMin bounds values from below
+// Usage: Col("balance").ClipMin(Lit(0))
+func (expr *ExprNode) ClipMin(lower *ExprNode) *ExprNode {
+	return expr.Clip(lower, nil)
+}
+
+// ClipMax bounds values from above
+// Usage: Col("score").ClipMax(Lit(100))
+func (expr *ExprNode) ClipMax(upper *ExprNode) *ExprNode {
+	return expr.Clip(nil, upper)
+}
+
 // Alias adds an alias to the expression for naming computed columns
 func (expr *ExprNode) Alias(name string) *ExprNode {
 	return expr.unaryOpWithAliasArgs(OpExprAlias, name)
 }
 
+// NamePrefix prepends prefix to the name of every output column; unlike Alias it also
+// renames each column of a multi-column selector
+// Example: Cols("^sensor_").Max().NamePrefix("max_")
+func (expr *ExprNode) NamePrefix(prefix string) *ExprNode {
+	return expr.unaryOpWithAliasArgs(OpExprNamePrefix, prefix)
+}
+
+// NameSuffix appends suffix to the name of every output column
+// Example: Cols("^sensor_").Mean().NameSuffix("_avg")
+func (expr *ExprNode) NameSuffix(suffix string) *ExprNode {
+	return expr.unaryOpWithAliasArgs(OpExprNameSuffix, suffix)
+}
+
+// KeepName names the output after the root input column, discarding any Alias
+func (expr *ExprNode) KeepName() *ExprNode {
+	return expr.unaryOp(OpExprNameKeep)
+}
+
 // String operations
 
+// ConcatStr joins exprs row-wise with separator; non-string inputs are cast to strings
+// A null in any input makes the row null; use ConcatStrIgnoreNulls to skip nulls instead.
+// Usage: ConcatStr("-", Col("dept"), Col("region")).Alias("key")
+func ConcatStr(separator string, exprs ...*ExprNode) *ExprNode {
+	return concatStr("ConcatStr", separator, exprs, false)
+}
+
+// ConcatStrIgnoreNulls joins exprs row-wise with separator, leaving out null inputs
+// A row is null only when every input is null.
+func ConcatStrIgnoreNulls(separator string, exprs ...*ExprNode) *ExprNode {
+	return concatStr("ConcatStrIgnoreNulls", separator, exprs, true)
+}
+
+// concatStr appends the inputs and a concat_str operation consuming them
+func concatStr(method, separator string, exprs []*ExprNode, ignoreNulls bool) *ExprNode {
+	return naryOp(method, exprs, Operation{
+		opcode: OpExprConcatStr,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.ConcatStrArgs{
+				separator:    makeRawStr(separator), // separator captured by closure
+				count:        C.size_t(len(exprs)),
+				ignore_nulls: C.bool(ignoreNulls),
+			})
+		},
+	})
+}
+
+// StrJoin concatenates a string column into a single separated string, skipping nulls
+// Inside Agg() it yields one string per group, like SQL GROUP_CONCAT / STRING_AGG.
+// Usage: df.GroupBy("dept").Agg(Col("name").StrJoin(", ").Alias("members"))
+func (expr *ExprNode) StrJoin(separator string) *ExprNode {
+	return expr.unaryOpWithStringArgs(OpExprStrJoin, separator)
+}
+
 // StrLen returns the length of each string as the number of characters
 func (expr *ExprNode) StrLen() *ExprNode {
 	return expr.unaryOp(OpExprStrLen)
@@ -427,6 +1114,191 @@ func (expr *ExprNode) StrEndsWith(suffix string) *ExprNode {
 	return expr.unaryOpWithStringArgs(OpExprStrEndsWith, suffix)
 }
 
+// StrExtract returns the given regex capture group (0 = whole match), null where there is no match
+// An invalid pattern is reported as an *Error when the plan is collected.
+// Usage: Col("log").StrExtract(`(\d{4}-\d{2}-\d{2})`, 1).Alias("date")
+func (expr *ExprNode) StrExtract(pattern string, group int) *ExprNode {
+	if group < 0 {
+		return &ExprNode{ops: combine(expr.ops, single(errOpf("StrExtract() group must be >= 0, got %d", group)))}
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprStrExtract,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.ExtractArgs{
+					pattern: makeRawStr(pattern), // pattern captured by closure, stays alive
+					group:   C.size_t(group),
+				})
+			},
+		})),
+	}
+}
+
+// StrStripChars removes any of the given characters from both ends of string values
+// An empty chars strips whitespace.
+// Usage: Col("department").StrStripChars("").StrToLowercase()
+func (expr *ExprNode) StrStripChars(chars string) *ExprNode {
+	return expr.unaryOpWithStringArgs(OpExprStrStripChars, chars)
+}
+
+// StrStripPrefix removes a literal prefix from string values that start with it
+func (expr *ExprNode) StrStripPrefix(prefix string) *ExprNode {
+	return expr.unaryOpWithStringArgs(OpExprStrStripPrefix, prefix)
+}
+
+// StrStripSuffix removes a literal suffix from string values that end with it
+func (expr *ExprNode) StrStripSuffix(suffix string) *ExprNode {
+	return expr.unaryOpWithStringArgs(OpExprStrStripSuffix, suffix)
+}
+
+// List operations - list columns come from Agg() without an aggregation or from List casts
+
+// ListLen returns the number of elements in each list as u32
+func (expr *ExprNode) ListLen() *ExprNode {
+	return expr.unaryOp(OpExprListLen)
+}
+
+// ListContains checks whether each list contains value, a Go literal or an *ExprNode
+// Usage: df.Filter(Col("tags").ListContains("urgent"))
+func (expr *ExprNode) ListContains(value any) *ExprNode {
+	item, ok := value.(*ExprNode)
+	if !ok {
+		item = Lit(value)
+	}
+	return binOp(expr, item, OpExprListContains)
+}
+
+// ListGet returns the element at index in each list; negative indices count from the end
+// Lists too short for the index give null.
+// Usage: Col("names").ListGet(-1) is the same as ListLast()
+func (expr *ExprNode) ListGet(index int) *ExprNode {
+	return binOp(expr, Lit(index), OpExprListGet)
+}
+
+// ListFirst returns the first element of each list (null for empty lists)
+func (expr *ExprNode) ListFirst() *ExprNode {
+	return expr.unaryOp(OpExprListFirst)
+}
+
+// ListLast returns the last element of each list (null for empty lists)
+func (expr *ExprNode) ListLast() *ExprNode {
+	return expr.unaryOp(OpExprListLast)
+}
+
+// Struct operations
+
+// StructField extracts one field of a struct column; the result is named after the field
+// Usage: ReadNDJSON("events.ndjson").Select(Col("user").StructField("city"))
+func (expr *ExprNode) StructField(name string) *ExprNode {
+	return expr.unaryOpWithStringArgs(OpExprStructField, name)
+}
+
+// Temporal operations
+
+// StrToDate parses strings into a Date column using a strptime format
+// Rows that fail to parse become null; use StrToDateStrict to fail instead.
+// Usage: Col("day").StrToDate("%Y-%m-%d")
+func (expr *ExprNode) StrToDate(format string) *ExprNode {
+	return expr.strptime(Date, format, false)
+}
+
+// StrToDateStrict parses strings into a Date column with configurable strict mode
+// strict=true: raise error on unparseable rows, strict=false: produce null values
+func (expr *ExprNode) StrToDateStrict(format string, strict bool) *ExprNode {
+	return expr.strptime(Date, format, strict)
+}
+
+// StrToDatetime parses strings into a Datetime column with the given time unit
+// timeUnit is one of DatetimeNanos, DatetimeMicros, DatetimeMillis or DatetimeSeconds.
+// Rows that fail to parse become null; use StrToDatetimeStrict to fail instead.
+// Usage: Col("stamp").StrToDatetime("%d/%m/%Y %H:%M", DatetimeMillis)
+func (expr *ExprNode) StrToDatetime(format string, timeUnit DataType) *ExprNode {
+	return expr.StrToDatetimeStrict(format, timeUnit, false)
+}
+
+// StrToDatetimeStrict parses strings into a Datetime column with configurable strict mode
+func (expr *ExprNode) StrToDatetimeStrict(format string, timeUnit DataType, strict bool) *ExprNode {
+	switch timeUnit {
+	case DatetimeNanos, DatetimeMicros, DatetimeMillis, DatetimeSeconds:
+		return expr.strptime(timeUnit, format, strict)
+	default:
+		return &ExprNode{ops: combine(expr.ops, single(errOpf("StrToDatetime() time unit must be a Datetime type, got 0x%08X", uint32(timeUnit))))}
+	}
+}
+
+// strptime appends a string parsing operation targeting a Date or Datetime type
+func (expr *ExprNode) strptime(dtype DataType, format string, strict bool) *ExprNode {
+	if format == "" {
+		return &ExprNode{ops: combine(expr.ops, single(errOp("strptime format must not be empty")))}
+	}
+
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprStrptime,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.StrptimeArgs{
+					format: makeRawStr(format), // format captured by closure, stays alive
+					dtype:  C.uint32_t(dtype),
+					strict: C.bool(strict),
+				})
+			},
+		})),
+	}
+}
+
+// durationPattern matches Polars duration strings such as "1h", "15m" or "1d12h"
+var durationPattern = regexp.MustCompile(`^([0-9]+(ns|us|ms|s|m|h|d|w|mo|q|y))+$`)
+
+// DtTruncate truncates Date/Datetime values down to a multiple of the given duration
+// The duration uses Polars syntax: ns, us, ms, s, m, h, d, w, mo, q, y (e.g. "1h", "1d12h").
+// Usage: df.GroupBy(Col("ts").DtTruncate("1h").Alias("hour"))
+func (expr *ExprNode) DtTruncate(every string) *ExprNode {
+	if !durationPattern.MatchString(every) {
+		return &ExprNode{ops: combine(expr.ops, single(errOpf("DtTruncate() invalid duration %q (expected e.g. \"1h\" or \"1d\")", every)))}
+	}
+	return expr.unaryOpWithStringArgs(OpExprDtTruncate, every)
+}
+
+// Date part extraction applies to Date and Datetime columns; other types fail with a
+// dtype error at Collect().
+
+// Year extracts the year as Int32
+// Usage: df.GroupBy(Col("timestamp").Year().Alias("yr"))
+func (expr *ExprNode) Year() *ExprNode {
+	return expr.unaryOp(OpExprDtYear)
+}
+
+// Month extracts the month (1-12) as Int8
+func (expr *ExprNode) Month() *ExprNode {
+	return expr.unaryOp(OpExprDtMonth)
+}
+
+// Day extracts the day of the month (1-31) as Int8
+func (expr *ExprNode) Day() *ExprNode {
+	return expr.unaryOp(OpExprDtDay)
+}
+
+// Hour extracts the hour (0-23) as Int8
+func (expr *ExprNode) Hour() *ExprNode {
+	return expr.unaryOp(OpExprDtHour)
+}
+
+// Minute extracts the minute (0-59) as Int8
+func (expr *ExprNode) Minute() *ExprNode {
+	return expr.unaryOp(OpExprDtMinute)
+}
+
+// Second extracts the second (0-59) as Int8
+func (expr *ExprNode) Second() *ExprNode {
+	return expr.unaryOp(OpExprDtSecond)
+}
+
+// Weekday extracts the ISO weekday (Monday = 1 ... Sunday = 7) as Int8
+func (expr *ExprNode) Weekday() *ExprNode {
+	return expr.unaryOp(OpExprDtWeekday)
+}
+
 // Window Functions
 
 // Over applies a window context to the expression with partition columns
@@ -660,3 +1532,21 @@ func (expr *ExprNode) CastWithOptions(dtype DataType, strict, wrap_numerical boo
 		})),
 	}
 }
+
+// Hashing Operations
+
+// Hash hashes each value of the expression to a u64
+// The same seed always produces the same hashes; different seeds produce different ones.
+// Usage: Col("user_id").Hash(42).Alias("shard_key")
+func (expr *ExprNode) Hash(seed uint64) *ExprNode {
+	return &ExprNode{
+		ops: combine(expr.ops, single(Operation{
+			opcode: OpExprHash,
+			args: func() unsafe.Pointer {
+				return unsafe.Pointer(&C.HashArgs{
+					seed: C.uint64_t(seed),
+				})
+			},
+		})),
+	}
+}
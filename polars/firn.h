@@ -11,19 +11,55 @@ typedef struct {
     size_t len;
 } RawStr;
 
+// Generic operation structure with opcode and args
+typedef struct {
+    uint32_t opcode;       // OpCode for the operation
+    uintptr_t args;        // Pointer to operation-specific args as uintptr_t
+} Operation;
+
 // Operation-specific argument structs
 typedef struct {
     RawStr* columns;
     int column_count;
 } SelectArgs;
 
+typedef struct {
+    bool maintain_order; // Emit groups in first-seen order instead of an arbitrary order
+} GroupByArgs;
+
+
+// CSV parsing options shared by every CSV reader (mirrors Go CSVReadConfig)
+typedef struct {
+    bool has_header;               // Whether CSV has header row
+    unsigned char separator;       // Field separator byte (0 = ',')
+    unsigned char quote_char;      // Quote byte (0 = '"')
+    unsigned char comment;         // Comment line prefix byte (0 = none)
+    RawStr* null_values;           // Tokens parsed as null, e.g. "NA" (NULL for none)
+    size_t null_value_count;       // Number of null tokens
+    size_t skip_rows;              // Lines skipped before the header
+    size_t skip_rows_after_header; // Rows skipped after the header
+    size_t n_rows;                 // Maximum rows to read (0 = all)
+    size_t infer_schema_length;    // Rows used to infer dtypes (0 = all rows)
+    RawStr* override_names;        // Columns whose dtype is forced (NULL for none)
+    uint32_t* override_dtypes;     // Bit-packed dtype per override name
+    size_t override_count;         // Number of dtype overrides
+    bool decimal_comma;            // Parse "1.234,56" style numbers as floats
+    RawStr* decimal_comma_columns; // Columns with '.' thousands separators (null = none)
+    size_t decimal_comma_column_count;
+} CsvConfigArgs;
 
 typedef struct {
     RawStr path;
-    bool has_header;  // Whether CSV has header row
-    bool with_glob;   // Whether to enable glob pattern expansion
+    bool with_glob;    // Whether to enable glob pattern expansion
+    CsvConfigArgs csv; // Parsing options
 } ReadCsvArgs;
 
+typedef struct {
+    const uint8_t* data; // CSV bytes (borrowed for the duration of the call)
+    size_t len;          // Number of bytes
+    CsvConfigArgs csv;   // Parsing options
+} ReadCsvBytesArgs;
+
 typedef struct {
     RawStr path;           // File path using zero-copy RawStr
     RawStr* columns;       // Optional column selection (null if not specified)
@@ -33,9 +69,66 @@ typedef struct {
     bool with_glob;        // Whether to expand glob patterns
 } ReadParquetArgs;
 
+// Arguments for reading JSON / NDJSON files
+typedef struct {
+    RawStr pattern;              // Path or glob as given, reported when nothing matches
+    RawStr* paths;               // Files to read (globs already expanded), in concat order
+    size_t count;                // Number of files
+    size_t infer_schema_length;  // Rows used to infer the schema (0 = all rows)
+} ReadJsonArgs;
+
+// Arguments for reading Arrow IPC (Feather v2) files
+typedef struct {
+    RawStr path;                 // File path or glob pattern
+} ReadIpcArgs;
+
+// In-memory column dtypes (matching Rust GO_COLUMN_* constants)
+#define GO_COLUMN_INT64 0
+#define GO_COLUMN_FLOAT64 1
+#define GO_COLUMN_STRING 2
+#define GO_COLUMN_BOOL 3
+
+typedef struct {
+    RawStr name;             // Column name
+    uint32_t dtype;          // GO_COLUMN_* - selects which values array is set
+    const int64_t* ints;     // GO_COLUMN_INT64 values
+    const double* floats;    // GO_COLUMN_FLOAT64 values
+    const RawStr* strings;   // GO_COLUMN_STRING values
+    const bool* bools;       // GO_COLUMN_BOOL values
+    const bool* valid;       // Per-row validity (NULL = no nulls)
+    size_t len;              // Number of rows
+} GoColumn;
+
+typedef struct {
+    const GoColumn* columns; // Columns in frame order
+    size_t column_count;     // Number of columns
+} FromColumnsArgs;
+
+// IPC compression codecs (matching Rust IPC_COMPRESSION_* constants)
+#define IPC_COMPRESSION_NONE 0
+#define IPC_COMPRESSION_LZ4 1
+#define IPC_COMPRESSION_ZSTD 2
+
+// Dataset member formats (matching Rust DATASET_FORMAT_* constants)
+#define DATASET_FORMAT_CSV 0
+#define DATASET_FORMAT_PARQUET 1
+
+typedef struct {
+    RawStr* paths;           // Files to read, in concat order
+    uint8_t* formats;        // DATASET_FORMAT_* per file
+    size_t count;            // Number of files
+    CsvConfigArgs csv;       // Options for the CSV files
+} ReadDatasetArgs;
+
+// Concat strategies (matching Rust CONCAT_HOW_* constants)
+#define CONCAT_HOW_VERTICAL 0
+#define CONCAT_HOW_HORIZONTAL 1
+#define CONCAT_HOW_DIAGONAL 2
+
 typedef struct {
     uintptr_t* handles; // Array of DataFrame handles
     size_t count;       // Number of handles
+    uint32_t how;       // CONCAT_HOW_* strategy
 } ConcatArgs;
 
 typedef struct {
@@ -46,6 +139,11 @@ typedef struct {
     unsigned char ddof; // Delta degrees of freedom (0=population, 1=sample)
 } AggregationArgs;
 
+typedef struct {
+    bool fisher; // Kurtosis only: subtract 3 so a normal distribution scores 0
+    bool bias;   // false applies the sample-size bias correction
+} MomentArgs;
+
 typedef struct {
     bool include_nulls; // Whether to include null values in count
 } CountArgs;
@@ -54,6 +152,17 @@ typedef struct {
     RawStr pattern; // Pattern/string for operations like contains, starts_with, ends_with
 } StringArgs;
 
+typedef struct {
+    RawStr pattern; // Regular expression
+    size_t group;   // Capture group to return (0 = whole match)
+} ExtractArgs;
+
+typedef struct {
+    RawStr separator;  // Placed between the joined values
+    size_t count;      // Expressions taken from the stack, in output order
+    bool ignore_nulls; // Skip null inputs instead of producing a null row
+} ConcatStrArgs;
+
 // Sort direction constants (matching Rust SortDirection enum)
 #define SORT_DIRECTION_ASCENDING 0
 #define SORT_DIRECTION_DESCENDING 1
@@ -85,12 +194,115 @@ typedef struct {
 typedef struct {
     SortField* fields;
     int field_count;
+    bool maintain_order; // Keep the original relative order of rows with equal keys
 } SortArgs;
 
+// Direction and nulls ordering for one expression sort key
+typedef struct {
+    SortDirection direction;
+    NullsOrdering nulls_ordering;
+} SortKeyOptions;
+
+// Arguments for expression sorts; the key expressions are on the expression stack
+typedef struct {
+    SortKeyOptions* keys;
+    int key_count;       // Must equal the number of expressions on the stack
+    bool maintain_order; // Keep the original relative order of rows with equal keys
+} SortExprArgs;
+
+// Arguments for top-k/bottom-k selection; the key expressions are on the expression stack
+typedef struct {
+    size_t k;            // Number of rows to keep
+    int key_count;       // Must equal the number of expressions on the stack
+    bool bottom;         // Keep the smallest keys instead of the largest
+} TopKArgs;
+
+// Unique keep strategies (matching Rust UNIQUE_KEEP_* constants)
+#define UNIQUE_KEEP_FIRST 0
+#define UNIQUE_KEEP_LAST 1
+#define UNIQUE_KEEP_ANY 2
+#define UNIQUE_KEEP_NONE 3
+
+// Arguments for unique operations
+typedef struct {
+    RawStr* subset;        // Key columns (null = all columns)
+    size_t subset_count;
+    uint32_t keep;         // UNIQUE_KEEP_* strategy
+    SortField* order;      // Optional ordering applied before deduplication (null if none)
+    int order_count;
+} UniqueArgs;
+
+// Arguments for drop_nulls operations
+typedef struct {
+    RawStr* subset;        // Columns checked for nulls (null = all columns)
+    size_t subset_count;
+} DropNullsArgs;
+
+// Arguments for drop operations
+typedef struct {
+    RawStr* columns;       // Columns to remove
+    size_t column_count;
+} DropArgs;
+
+// Arguments for unnest operations
+typedef struct {
+    RawStr* columns;       // Struct columns to expand
+    size_t column_count;
+} UnnestArgs;
+
+// Arguments for rename operations (parallel old/new name arrays)
+typedef struct {
+    RawStr* existing;      // Current column names
+    RawStr* renamed;       // New names, same length as existing
+    size_t count;          // 0 = rename every column with prefix/suffix
+    RawStr prefix;
+    RawStr suffix;
+} RenameArgs;
+
 typedef struct {
     size_t n;            // Number of rows to limit to
 } LimitArgs;
 
+typedef struct {
+    bool streaming;         // Run the plan on the streaming engine
+    uintptr_t cancel_token; // Token from new_cancel_token (0 = not cancellable)
+} CollectArgs;
+
+typedef struct {
+    size_t n_rows;       // Number of rows to read from each source
+} FetchArgs;
+
+typedef struct {
+    size_t n;            // Keep every n-th row
+    size_t offset;       // Position of the first row to keep
+} GatherEveryArgs;
+
+typedef struct {
+    int64_t offset;         // First row (negative counts from the end)
+    size_t length;          // Maximum number of rows
+} SliceArgs;
+
+typedef struct {
+    size_t n;               // Number of rows (0 when sampling by fraction)
+    double fraction;        // Fraction of rows (0 when sampling by count)
+    bool with_replacement;
+    uint64_t seed;          // Fixed seed for reproducible samples
+} SampleArgs;
+
+typedef struct {
+    RawStr column;          // Column whose distinct values are counted
+    bool sort;              // Most frequent first, ties by value
+    bool normalize;         // Proportions instead of counts
+} ValueCountsArgs;
+
+// Correlation methods (matching Rust CORR_METHOD_* constants)
+#define CORR_METHOD_PEARSON 0
+#define CORR_METHOD_SPEARMAN 1
+
+typedef struct {
+    uint32_t method;     // CORR_METHOD_* correlation method
+} CorrMatrixArgs;
+
 typedef struct {
     RawStr sql;
 } QueryArgs;
@@ -117,8 +329,66 @@ typedef struct {
     JoinType how;               // Join type (inner, left, etc.)
     RawStr suffix;              // Optional suffix for duplicate columns
     bool coalesce;              // Whether to coalesce join columns (default false)
+    bool indicator;             // Whether to add a _merge column naming each row's source side
+    uint32_t other_context_type; // Context type of the right handle
+    const Operation* other_ops; // Right frame's pending operations (null if none)
+    size_t other_op_count;
 } JoinArgs;
 
+// As-of join strategies (matching Rust ASOF_STRATEGY_* constants)
+#define ASOF_STRATEGY_BACKWARD 0
+#define ASOF_STRATEGY_FORWARD 1
+#define ASOF_STRATEGY_NEAREST 2
+
+// Arguments for as-of join operations
+typedef struct {
+    uintptr_t other_handle;     // Handle to the right DataFrame
+    uint32_t other_context_type; // Context type of the right handle
+    RawStr left_on;             // Left asof key (must be sorted)
+    RawStr right_on;            // Right asof key (must be sorted)
+    RawStr* by;                 // Optional grouping columns matched exactly (null if none)
+    size_t by_count;
+    uint32_t strategy;          // ASOF_STRATEGY_* constant
+    RawStr tolerance;           // Optional max key distance: number or duration string (empty = none)
+    const Operation* other_ops; // Right frame's pending operations (null if none)
+    size_t other_op_count;
+} AsofJoinArgs;
+
+// Arguments for unpivot (melt) operations
+typedef struct {
+    RawStr* id_vars;            // Identifier columns (null if none)
+    size_t id_count;
+    RawStr* value_vars;         // Columns to unpivot (null if none)
+    size_t value_count;
+    uint32_t* id_dtypes;        // Dtype selector for identifier columns (bit-packed encoding)
+    size_t id_dtype_count;
+    uint32_t* value_dtypes;     // Dtype selector for value columns (bit-packed encoding)
+    size_t value_dtype_count;
+    RawStr variable_name;       // Name of the variable column (empty = "variable")
+    RawStr value_name;          // Name of the value column (empty = "value")
+} UnpivotArgs;
+
+// Pivot aggregations (matching Rust PIVOT_AGG_* constants)
+#define PIVOT_AGG_FIRST 0
+#define PIVOT_AGG_LAST 1
+#define PIVOT_AGG_SUM 2
+#define PIVOT_AGG_MEAN 3
+#define PIVOT_AGG_MEDIAN 4
+#define PIVOT_AGG_MIN 5
+#define PIVOT_AGG_MAX 6
+#define PIVOT_AGG_COUNT 7
+
+// Pivot operation arguments (long to wide)
+typedef struct {
+    RawStr* index;              // Row identifier columns (null = all other columns)
+    size_t index_count;
+    RawStr* on;                 // Columns whose values become new column names
+    size_t on_count;
+    RawStr* values;             // Columns to aggregate into cells (null = all other columns)
+    size_t values_count;
+    uint32_t agg;               // PIVOT_AGG_* constant
+} PivotArgs;
+
 // Window function arguments
 typedef struct {
     RawStr* partition_columns;
@@ -138,6 +408,58 @@ typedef struct {
     bool wrap_numerical;     // If true, wrap overflowing numeric values instead of marking invalid
 } CastArgs;
 
+typedef struct {
+    RawStr format;           // strptime format, e.g. "%Y-%m-%d"
+    uint32_t dtype;          // Bit-packed Date or Datetime target type
+    bool strict;             // Error on unparseable rows instead of producing nulls
+} StrptimeArgs;
+
+typedef struct {
+    uint32_t decimals;       // Digits kept after the decimal point
+} RoundArgs;
+
+typedef struct {
+    double base;             // Logarithm base (positive, not 1)
+} LogArgs;
+
+typedef struct {
+    bool has_lower;          // Lower bound expression is on the stack
+    bool has_upper;          // Upper bound expression is on the stack (above lower)
+} ClipArgs;
+
+typedef struct {
+    bool reverse;            // Accumulate from the last row towards the first
+} CumulativeArgs;
+
+typedef struct {
+    int64_t n;               // Rows to shift by (negative shifts towards the start)
+} ShiftArgs;
+
+typedef struct {
+    uint32_t limit;          // Maximum consecutive nulls to fill (0 = unlimited)
+} FillArgs;
+
+typedef struct {
+    size_t count;            // Expressions taken from the stack, in priority order
+} CoalesceArgs;
+
+typedef struct {
+    uint64_t seed;           // Hash seed; equal seeds produce equal hashes
+} HashArgs;
+
+// Quantile interpolation constants (matching Rust QUANTILE_INTERP_* constants)
+#define QUANTILE_INTERP_NEAREST 0
+#define QUANTILE_INTERP_LOWER 1
+#define QUANTILE_INTERP_HIGHER 2
+#define QUANTILE_INTERP_MIDPOINT 3
+#define QUANTILE_INTERP_LINEAR 4
+
+typedef struct {
+    const double* quantiles; // Quantiles in [0, 1]
+    size_t count;            // Number of quantiles (1 for a single Quantile)
+    uint32_t interp;         // QUANTILE_INTERP_* constant
+} QuantileArgs;
+
 // Centralized literal abstraction - handles all value types
 typedef struct {
     int value_type;       // 0=int, 1=float, 2=string, 3=bool
@@ -156,11 +478,20 @@ typedef struct {
     Literal literal;
 } LiteralArgs;
 
-// Generic operation structure with opcode and args
 typedef struct {
-    uint32_t opcode;       // OpCode for the operation
-    uintptr_t args;        // Pointer to operation-specific args as uintptr_t
-} Operation;
+    const Literal* values; // Set members, all of the same value_type
+    size_t count;          // Number of members
+} IsInArgs;
+
+typedef struct {
+    const RawStr* columns; // Column names removed from a multi-column selector
+    size_t count;          // Number of names
+} ExcludeArgs;
+
+typedef struct {
+    const uint32_t* dtypes; // Data types whose columns are selected (bit-packed encoding)
+    size_t count;           // Number of data types
+} DtypeColsArgs;
 
 // Filter with expression arguments
 typedef struct {
@@ -174,6 +505,11 @@ typedef struct {
     uint32_t context_type; // ContextType as u32 for C compatibility
 } PolarsHandle;
 
+// Handle context types (matching Rust ContextType)
+#define CONTEXT_DATAFRAME 1    // Materialized DataFrame
+#define CONTEXT_LAZYFRAME 2    // Lazy query plan, resolved by Collect
+#define CONTEXT_LAZYGROUPBY 3  // Grouped lazy frame awaiting Agg
+
 typedef struct {
     PolarsHandle polars_handle; // Handle with context type
     int error_code;
@@ -181,16 +517,90 @@ typedef struct {
     size_t error_frame;
 } FfiResult;
 
+// Apache Arrow C Data Interface and C Stream Interface
+// See https://arrow.apache.org/docs/format/CDataInterface.html
+#ifndef ARROW_C_DATA_INTERFACE
+#define ARROW_C_DATA_INTERFACE
+
+#define ARROW_FLAG_DICTIONARY_ORDERED 1
+#define ARROW_FLAG_NULLABLE 2
+#define ARROW_FLAG_MAP_KEYS_SORTED 4
+
+struct ArrowSchema {
+    const char* format;
+    const char* name;
+    const char* metadata;
+    int64_t flags;
+    int64_t n_children;
+    struct ArrowSchema** children;
+    struct ArrowSchema* dictionary;
+    void (*release)(struct ArrowSchema*);
+    void* private_data;
+};
+
+struct ArrowArray {
+    int64_t length;
+    int64_t null_count;
+    int64_t offset;
+    int64_t n_buffers;
+    int64_t n_children;
+    const void** buffers;
+    struct ArrowArray** children;
+    struct ArrowArray* dictionary;
+    void (*release)(struct ArrowArray*);
+    void* private_data;
+};
+
+#endif // ARROW_C_DATA_INTERFACE
+
+#ifndef ARROW_C_STREAM_INTERFACE
+#define ARROW_C_STREAM_INTERFACE
+
+struct ArrowArrayStream {
+    int (*get_schema)(struct ArrowArrayStream*, struct ArrowSchema* out);
+    int (*get_next)(struct ArrowArrayStream*, struct ArrowArray* out);
+    const char* (*get_last_error)(struct ArrowArrayStream*);
+    void (*release)(struct ArrowArrayStream*);
+    void* private_data;
+};
+
+#endif // ARROW_C_STREAM_INTERFACE
+
 // Core FFI functions - these are the only functions called from Go
 FfiResult execute_operations(PolarsHandle handle, const Operation* operations, size_t count);
 int release_dataframe(uintptr_t handle);
+int retain_handle(uintptr_t handle);
+size_t handle_ref_count(uintptr_t handle);
+
+// Cancellation tokens for CollectCtx
+uintptr_t new_cancel_token(void);
+void cancel_token(uintptr_t token);
+void free_cancel_token(uintptr_t token);
 void free_string(char* error_message);
 
 // DataFrame introspection
 size_t dataframe_height(uintptr_t handle);
+size_t dataframe_width(uintptr_t handle);
+RawStr dataframe_column_name(uintptr_t handle, size_t index); // Borrowed; valid until release
 char* dataframe_to_csv(uintptr_t handle);
+int dataframe_to_csv_bytes(uintptr_t handle, bool include_header, unsigned char separator,
+                           uint8_t** out_data, size_t* out_len);
+void free_bytes(uint8_t* data, size_t len);
+
+// File writers (stream an executed DataFrame to path; I/O failures report error code 5)
+FfiResult write_csv(uintptr_t handle, RawStr path, bool include_header, unsigned char separator,
+                    size_t float_precision);
+FfiResult write_json(uintptr_t handle, RawStr path, bool ndjson);
+FfiResult write_ipc(uintptr_t handle, RawStr path, uint32_t compression);
 char* dataframe_to_string(uintptr_t handle);
 
+// Arrow export (result written to caller-allocated struct, returns 0 on success)
+int dataframe_to_arrow_stream(uintptr_t handle, size_t batch_size, struct ArrowArrayStream* out);
+int dataframe_to_arrow_array(uintptr_t handle, struct ArrowSchema* out_schema, struct ArrowArray* out_array);
+// Returns 2 when the column does not exist
+int dataframe_column_to_arrow(uintptr_t handle, RawStr name, struct ArrowSchema* out_schema,
+                              struct ArrowArray* out_array);
+
 // Testing and benchmarking helpers
 FfiResult dispatch_add_null_row(uintptr_t handle, uintptr_t args);
 int noop();
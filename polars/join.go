@@ -5,6 +5,8 @@ package polars
 */
 import "C"
 import (
+	"fmt"
+	"slices"
 	"unsafe"
 )
 
@@ -27,6 +29,7 @@ type JoinSpec struct {
 	joinType  JoinType
 	suffix    string
 	coalesce  bool
+	indicator bool
 }
 
 // On creates a JoinSpec for joining on the same column names in both DataFrames
@@ -82,7 +85,82 @@ func (spec JoinSpec) WithCoalesce(coalesce bool) JoinSpec {
 	return spec
 }
 
+// WithIndicator adds a "_merge" column valued "left_only", "right_only" or "both"
+// recording which side each joined row came from (like pandas' indicator=True)
+func (spec JoinSpec) WithIndicator(indicator bool) JoinSpec {
+	spec.indicator = indicator
+	return spec
+}
+
+// joinRight is the right-hand input of a join: the other frame's handle (0 if it was never
+// executed) plus a copy of its pending operations, which run inside the join so Polars plans
+// both inputs together. The other frame is left untouched and is still released by its owner.
+type joinRight struct {
+	handle   C.PolarsHandle
+	ops      []Operation
+	retained []C.uintptr_t // Handles the join co-owns until Rust resolves the right side
+}
+
+// captureJoinRight snapshots other for a join, surfacing any error already in its chain
+// The join retains other's handle, and again every handle retained by the copied operations,
+// so collecting or releasing other before the join runs cannot free data the join still
+// reads. Rust drops these references when it dispatches the join.
+func captureJoinRight(name string, other *DataFrame) (joinRight, error) {
+	for _, op := range other.operations {
+		if op.err != nil {
+			return joinRight{}, fmt.Errorf("%s: other DataFrame: %w", name, op.err)
+		}
+	}
+	if other.handle.handle == 0 && len(other.operations) == 0 {
+		return joinRight{}, fmt.Errorf("%s: other DataFrame has no data", name)
+	}
+
+	right := joinRight{handle: other.handle, ops: slices.Clone(other.operations)}
+	if other.handle.handle != 0 {
+		shared, err := other.shareHandle()
+		if err != nil {
+			return joinRight{}, fmt.Errorf("%s: other DataFrame: %w", name, err)
+		}
+		right.retained = append(right.retained, shared.handle.handle)
+	}
+	for _, op := range right.ops {
+		for _, handle := range op.retained {
+			C.retain_handle(handle) // The copy runs separately from other's own chain
+			right.retained = append(right.retained, handle)
+		}
+	}
+	return right, nil
+}
+
+// fill sets the right-hand fields of JoinArgs
+func (r joinRight) fill(args *C.JoinArgs) {
+	args.other_handle = r.handle.handle
+	args.other_context_type = r.handle.context_type
+	args.other_ops, args.other_op_count = r.cOps()
+}
+
+// fillAsof sets the right-hand fields of AsofJoinArgs
+func (r joinRight) fillAsof(args *C.AsofJoinArgs) {
+	args.other_handle = r.handle.handle
+	args.other_context_type = r.handle.context_type
+	args.other_ops, args.other_op_count = r.cOps()
+}
+
+// cOps converts the captured operations for Rust (nil when there are none)
+func (r joinRight) cOps() (*C.Operation, C.size_t) {
+	if len(r.ops) == 0 {
+		return nil, 0
+	}
+	cOps := make([]C.Operation, len(r.ops))
+	for i, op := range r.ops {
+		cOps[i] = op.cOperation()
+	}
+	return &cOps[0], C.size_t(len(cOps))
+}
+
 // Join performs a join operation with another DataFrame
+// other may be collected or still lazy, e.g. df.Join(other.Filter(...), spec); its pending
+// operations are planned together with the join and other itself is not modified.
 func (df *DataFrame) Join(other *DataFrame, spec JoinSpec) *DataFrame {
 	// Validate inputs
 	if other == nil {
@@ -98,9 +176,9 @@ func (df *DataFrame) Join(other *DataFrame, spec JoinSpec) *DataFrame {
 			len(spec.leftOn), len(spec.rightOn))
 	}
 
-	// We need the other DataFrame to be executed to get its handle
-	if other.handle.handle == 0 {
-		return df.appendErrOp("Join: other DataFrame must be executed first (call Collect())")
+	right, err := captureJoinRight("Join", other)
+	if err != nil {
+		return df.appendErrOp(err.Error())
 	}
 
 	op := Operation{
@@ -118,19 +196,91 @@ func (df *DataFrame) Join(other *DataFrame, spec JoinSpec) *DataFrame {
 				rightRawStrs[i] = makeRawStr(col)
 			}
 
-			return unsafe.Pointer(&C.JoinArgs{
-				other_handle:  C.uintptr_t(other.handle.handle),
+			args := &C.JoinArgs{
 				left_on:      (*C.RawStr)(unsafe.Pointer(&leftRawStrs[0])),
 				right_on:     (*C.RawStr)(unsafe.Pointer(&rightRawStrs[0])),
 				column_count: C.uintptr_t(len(spec.leftOn)),
 				how:          C.JoinType(spec.joinType),
 				suffix:       makeRawStr(spec.suffix),
 				coalesce:     C.bool(spec.coalesce),
-			})
+				indicator:    C.bool(spec.indicator),
+			}
+			right.fill(args)
+			return unsafe.Pointer(args)
+		},
+		retained: right.retained,
+	}
+
+	df.appendOps("Join()", op)
+	return df
+}
+
+// AsofJoinSpec describes an as-of join: each left row is matched with the closest right row
+// by a sorted key, rather than an exact key match (e.g. aligning trades with the latest quote)
+type AsofJoinSpec struct {
+	LeftOn   string   // Left asof key column
+	RightOn  string   // Right asof key column ("" = same as LeftOn)
+	By       []string // Optional columns that must match exactly, e.g. the ticker
+	Strategy string   // "backward" (default: last right key <= left key), "forward" or "nearest"
+	// Tolerance is the optional maximum key distance to accept a match: a number for
+	// numeric keys ("2.5") or a duration for temporal keys ("5m"); "" allows any distance.
+	Tolerance string
+}
+
+// asofStrategies maps AsofJoinSpec.Strategy names to ASOF_STRATEGY_* constants
+var asofStrategies = map[string]C.uint32_t{
+	"":         C.ASOF_STRATEGY_BACKWARD,
+	"backward": C.ASOF_STRATEGY_BACKWARD,
+	"forward":  C.ASOF_STRATEGY_FORWARD,
+	"nearest":  C.ASOF_STRATEGY_NEAREST,
+}
+
+// JoinAsof performs an as-of join with another DataFrame, which may be collected or still lazy
+// Both frames must be sorted by their asof key (within each By group): Polars rejects keys it
+// can see are unsorted, and otherwise matches are undefined. Left rows without a match get
+// nulls for the right columns.
+// Example: trades.JoinAsof(quotes, AsofJoinSpec{LeftOn: "time", By: []string{"ticker"}})
+func (df *DataFrame) JoinAsof(other *DataFrame, spec AsofJoinSpec) *DataFrame {
+	if other == nil {
+		return df.appendErrOp("JoinAsof: other DataFrame cannot be nil")
+	}
+	if spec.LeftOn == "" {
+		return df.appendErrOp("JoinAsof: LeftOn cannot be empty")
+	}
+	strategy, ok := asofStrategies[spec.Strategy]
+	if !ok {
+		return df.appendErrOpf("JoinAsof: unknown strategy %q (want backward, forward or nearest)", spec.Strategy)
+	}
+
+	right, err := captureJoinRight("JoinAsof", other)
+	if err != nil {
+		return df.appendErrOp(err.Error())
+	}
+
+	rightOn := spec.RightOn
+	if rightOn == "" {
+		rightOn = spec.LeftOn
+	}
+
+	op := Operation{
+		opcode: OpJoinAsof,
+		args: func() unsafe.Pointer {
+			byPtr, byCount := makeRawStrArray(spec.By)
+			args := &C.AsofJoinArgs{
+				left_on:   makeRawStr(spec.LeftOn),
+				right_on:  makeRawStr(rightOn),
+				by:        byPtr,
+				by_count:  byCount,
+				strategy:  strategy,
+				tolerance: makeRawStr(spec.Tolerance),
+			}
+			right.fillAsof(args)
+			return unsafe.Pointer(args)
 		},
+		retained: right.retained,
 	}
 
-	df.operations = append(df.operations, op)
+	df.appendOps("JoinAsof()", op)
 	return df
 }
 
@@ -163,27 +313,30 @@ func (df *DataFrame) CrossJoin(other *DataFrame) *DataFrame {
 		return df.appendErrOp("CrossJoin: other DataFrame cannot be nil")
 	}
 
-	// We need the other DataFrame to be executed to get its handle
-	if other.handle.handle == 0 {
-		return df.appendErrOp("CrossJoin: other DataFrame must be executed first (call Collect())")
+	right, err := captureJoinRight("CrossJoin", other)
+	if err != nil {
+		return df.appendErrOp(err.Error())
 	}
 
 	op := Operation{
 		opcode: OpJoin,
 		args: func() unsafe.Pointer {
 			// Cross join doesn't use join columns, so pass empty arrays
-			return unsafe.Pointer(&C.JoinArgs{
-				other_handle:  C.uintptr_t(other.handle.handle),
+			args := &C.JoinArgs{
 				left_on:      nil, // No join columns for cross join
 				right_on:     nil, // No join columns for cross join
 				column_count: C.uintptr_t(0), // No columns
 				how:          C.JoinType(JoinTypeCross),
 				suffix:       makeRawStr(""),
 				coalesce:     C.bool(false),
-			})
+				indicator:    C.bool(false),
+			}
+			right.fill(args)
+			return unsafe.Pointer(args)
 		},
+		retained: right.retained,
 	}
 
-	df.operations = append(df.operations, op)
+	df.appendOps("CrossJoin()", op)
 	return df
 }
@@ -10,23 +10,48 @@ import "C"
 // update these constants to match the Rust enum values exactly!
 const (
 	// DataFrame operations
-	OpNewEmpty    = 1
-	OpReadCsv     = 2
-	OpReadParquet = 3
-	OpSelect      = 4
-	OpSelectExpr  = 5
-	OpCount       = 6
-	OpConcat      = 7
-	OpWithColumn  = 8
-	OpFilterExpr  = 9
-	OpGroupBy     = 10
-	OpAddNullRow  = 11
-	OpCollect     = 12
-	OpAgg         = 13
-	OpSort        = 14
-	OpLimit       = 15
-	OpQuery       = 16
-	OpJoin        = 17
+	OpNewEmpty     = 1
+	OpReadCsv      = 2
+	OpReadParquet  = 3
+	OpSelect       = 4
+	OpSelectExpr   = 5
+	OpCount        = 6
+	OpConcat       = 7
+	OpWithColumn   = 8
+	OpFilterExpr   = 9
+	OpGroupBy      = 10
+	OpAddNullRow   = 11
+	OpCollect      = 12
+	OpAgg          = 13
+	OpSort         = 14
+	OpLimit        = 15
+	OpQuery        = 16
+	OpJoin         = 17
+	OpUnpivot      = 18
+	OpReadCsvBytes = 19
+	OpFetch        = 20
+	OpGatherEvery  = 21
+	OpSortExpr     = 22
+	OpReadDataset  = 23
+	OpUnique       = 24
+	OpCorrMatrix   = 25
+	OpDropNulls    = 26
+	OpTail         = 27
+	OpDrop         = 28
+	OpRename       = 29
+	OpPivot        = 30
+	OpSample       = 31
+	OpSlice        = 32
+	OpReverse      = 33
+	OpJoinAsof     = 34
+	OpReadJson     = 35
+	OpReadNdjson   = 36
+	OpReadIpc      = 37
+	OpFromColumns  = 38
+	OpNullCount    = 39
+	OpUnnest       = 40
+	OpValueCounts  = 41
+	OpTopK         = 42
 	
 	// Expression operations (stack-based)
 	OpExprColumn         = 100
@@ -63,6 +88,12 @@ const (
 	OpExprStrToLowercase = 131
 	OpExprStrToUppercase = 132
 	OpExprSql            = 133
+	OpExprDot            = 134
+	OpExprHash           = 135
+	OpExprQuantile       = 136
+	OpExprQuantiles      = 137
+	OpExprWeightedMean   = 138
+	OpExprClipQuantile   = 139
 	
 	// Window function operations
 	OpExprOver       = 140 // Applies window context to previous expression
@@ -80,6 +111,108 @@ const (
 	// Cast operations
 	OpExprCast = 160 // Cast expression to different data type
 
+	// Additional comparison operations
+	OpExprGe   = 170 // Greater than or equal
+	OpExprLe   = 171 // Less than or equal
+	OpExprNe   = 172 // Not equal
+	OpExprIsIn = 173 // Membership in a literal set
+
+	// Null handling operations
+	OpExprFillNull     = 180 // Replace nulls with another expression
+	OpExprForwardFill  = 181 // Fill nulls with the previous non-null value
+	OpExprBackwardFill = 182 // Fill nulls with the next non-null value
+	OpExprInterpolate  = 183 // Fill nulls linearly between the surrounding values
+	OpExprCoalesce     = 184 // First non-null value across several expressions
+
+	// Numeric operations
+	OpExprAbs   = 190 // Absolute value
+	OpExprRound = 191 // Round to a number of decimals
+	OpExprFloor = 192 // Round down to an integer value
+	OpExprCeil  = 193 // Round up to an integer value
+	OpExprPow   = 194 // Raise to the power of another expression
+	OpExprSqrt  = 195 // Square root
+	OpExprExp   = 196 // Natural exponential
+	OpExprLog   = 197 // Logarithm with an explicit base
+	OpExprMod   = 198 // Remainder of division
+	OpExprClip  = 199 // Bound values to optional lower/upper expressions
+
+	// Additional string operations
+	OpExprStrStripChars  = 200 // Strip characters (or whitespace) from both ends
+	OpExprStrStripPrefix = 201 // Remove a literal prefix
+	OpExprStrStripSuffix = 202 // Remove a literal suffix
+	OpExprStrExtract     = 203 // Extract a regex capture group
+	OpExprConcatStr      = 204 // Join several expressions row-wise with a separator
+	OpExprStrJoin        = 205 // Aggregate all values into one separated string
+
+	// Temporal operations
+	OpExprDtYear     = 210
+	OpExprDtMonth    = 211
+	OpExprDtDay      = 212
+	OpExprDtHour     = 213
+	OpExprDtMinute   = 214
+	OpExprDtSecond   = 215
+	OpExprDtWeekday  = 216 // ISO weekday: Monday = 1 ... Sunday = 7
+	OpExprStrptime   = 217 // Parse strings into Date/Datetime with a strptime format
+	OpExprDtTruncate = 218 // Truncate to a duration interval (e.g. "1h")
+
+	// Cumulative operations (row-preserving)
+	OpExprCumsum  = 220
+	OpExprCummax  = 221
+	OpExprCummin  = 222
+	OpExprCumprod = 223
+
+	// Row offset operations
+	OpExprShift     = 225 // Shift values by n rows (nulls fill the boundary)
+	OpExprPctChange = 226 // Percentage change from n rows earlier
+
+	// Positional aggregations (u32 row index within the group or frame)
+	OpExprArgMax = 230
+	OpExprArgMin = 231
+
+	// Distribution shape aggregations
+	OpExprSkew     = 232
+	OpExprKurtosis = 233
+
+	// Multiplicative aggregations
+	OpExprProduct = 235
+
+	// Float predicates (NaN and infinity are distinct from null)
+	OpExprIsNaN      = 240
+	OpExprIsNotNaN   = 241
+	OpExprIsFinite   = 242
+	OpExprIsInfinite = 243
+
+	// Uniqueness predicates
+	OpExprIsUnique     = 245
+	OpExprIsDuplicated = 246
+
+	// Selector refinement
+	OpExprExclude = 247
+
+	// Output name templating
+	OpExprNamePrefix = 248
+	OpExprNameSuffix = 249
+	OpExprNameKeep   = 250
+
+	// Row count of the frame or group (SQL COUNT(*))
+	OpExprLen = 251
+
+	// Missing-value aggregations
+	OpExprNullCount = 252
+
+	// List operations
+	OpExprListLen      = 253 // Number of elements in each list
+	OpExprListContains = 254 // Whether each list contains a value
+	OpExprListGet      = 255 // Element at an index (negative counts from the end)
+	OpExprListFirst    = 256
+	OpExprListLast     = 257
+
+	// Struct operations
+	OpExprStructField = 258 // Field of a struct column by name
+
+	// Columns selected by data type
+	OpExprDtypeCols = 259
+
 	// Error operation for fluent API error handling
 	OpError = 999
 )
@@ -0,0 +1,113 @@
+package polars
+
+/*
+#include "firn.h"
+*/
+import "C"
+import (
+	"unsafe"
+)
+
+// pivotAggs maps the aggregation names accepted by Pivot to PIVOT_AGG_* constants
+var pivotAggs = map[string]C.uint32_t{
+	"first":  C.PIVOT_AGG_FIRST,
+	"last":   C.PIVOT_AGG_LAST,
+	"sum":    C.PIVOT_AGG_SUM,
+	"mean":   C.PIVOT_AGG_MEAN,
+	"median": C.PIVOT_AGG_MEDIAN,
+	"min":    C.PIVOT_AGG_MIN,
+	"max":    C.PIVOT_AGG_MAX,
+	"count":  C.PIVOT_AGG_COUNT,
+}
+
+// UnpivotOptions configures an unpivot (melt) operation from wide to long format
+type UnpivotOptions struct {
+	IdVars        []string // Identifier columns kept as-is on every output row
+	ValueVars     []string // Columns to unpivot into variable/value pairs
+	IdSelector    Selector // Optional dtype selector adding identifier columns
+	ValueSelector Selector // Optional dtype selector adding value columns
+	VariableName  string   // Name of the variable column ("" = "variable")
+	ValueName     string   // Name of the value column ("" = "value")
+}
+
+// Unpivot reshapes the DataFrame from wide to long format using explicit column names
+// An empty valueVars unpivots every column not in idVars; empty names default to
+// "variable" and "value".
+// Example: df.Unpivot([]string{"sensor"}, nil, "metric", "reading")
+func (df *DataFrame) Unpivot(idVars []string, valueVars []string, variableName, valueName string) *DataFrame {
+	return df.UnpivotWithOptions(UnpivotOptions{
+		IdVars:       idVars,
+		ValueVars:    valueVars,
+		VariableName: variableName,
+		ValueName:    valueName,
+	})
+}
+
+// UnpivotWithOptions reshapes the DataFrame from wide to long format
+// When neither ValueVars nor ValueSelector is set, all non-identifier columns are unpivoted
+// Example: df.UnpivotWithOptions(UnpivotOptions{IdSelector: StringSelector(), ValueSelector: NumericSelector()})
+func (df *DataFrame) UnpivotWithOptions(opts UnpivotOptions) *DataFrame {
+	op := Operation{
+		opcode: OpUnpivot,
+		args: func() unsafe.Pointer {
+			idVars, idCount := makeRawStrArray(opts.IdVars)
+			valueVars, valueCount := makeRawStrArray(opts.ValueVars)
+			idDtypes, idDtypeCount := makeDtypeArray(opts.IdSelector.dtypes)
+			valueDtypes, valueDtypeCount := makeDtypeArray(opts.ValueSelector.dtypes)
+
+			return unsafe.Pointer(&C.UnpivotArgs{
+				id_vars:           idVars,
+				id_count:          idCount,
+				value_vars:        valueVars,
+				value_count:       valueCount,
+				id_dtypes:         idDtypes,
+				id_dtype_count:    idDtypeCount,
+				value_dtypes:      valueDtypes,
+				value_dtype_count: valueDtypeCount,
+				variable_name:     makeRawStr(opts.VariableName),
+				value_name:        makeRawStr(opts.ValueName),
+			})
+		},
+	}
+
+	df.appendOps("UnpivotWithOptions()", op)
+	return df
+}
+
+// Pivot reshapes the DataFrame from long to wide format
+// Each distinct value of the columns becomes a new column, filled with the values aggregated
+// by aggFn ("first", "last", "sum", "mean", "median", "min", "max" or "count") per index row.
+// An empty index or values means all remaining columns. The output columns depend on the
+// data, so Pivot collects its input; rows and new columns keep their order of first appearance.
+// Example: df.Pivot([]string{"city"}, []string{"month"}, []string{"temp"}, "mean")
+func (df *DataFrame) Pivot(index []string, columns []string, values []string, aggFn string) *DataFrame {
+	if len(columns) == 0 {
+		return df.appendErrOp("Pivot() requires at least one column to pivot on")
+	}
+	agg, ok := pivotAggs[aggFn]
+	if !ok {
+		return df.appendErrOpf("Pivot() unknown aggregation %q", aggFn)
+	}
+
+	op := Operation{
+		opcode: OpPivot,
+		args: func() unsafe.Pointer {
+			indexPtr, indexCount := makeRawStrArray(index)
+			onPtr, onCount := makeRawStrArray(columns)
+			valuesPtr, valuesCount := makeRawStrArray(values)
+
+			return unsafe.Pointer(&C.PivotArgs{
+				index:        indexPtr,
+				index_count:  indexCount,
+				on:           onPtr,
+				on_count:     onCount,
+				values:       valuesPtr,
+				values_count: valuesCount,
+				agg:          agg,
+			})
+		},
+	}
+
+	df.appendOps("Pivot()", op)
+	return df
+}
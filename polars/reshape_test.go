@@ -0,0 +1,88 @@
+package polars
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestReshapeOperations demonstrates wide-to-long and long-to-wide reshaping
+func TestReshapeOperations(t *testing.T) {
+	t.Run("UnpivotByDtypeSelector", func(t *testing.T) {
+		// Mixed frame: string columns become ids, numeric columns are melted
+		df := ReadCSV("../testdata/sample.csv").Limit(2)
+		result, err := df.UnpivotWithOptions(UnpivotOptions{
+			IdSelector:    StringSelector(),
+			ValueSelector: NumericSelector(),
+		}).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: each numeric column contributes one row per input row
+		expected := `shape: (4, 4)
+┌───────┬─────────────┬──────────┬───────┐
+│ name  ┆ department  ┆ variable ┆ value │
+│ ---   ┆ ---         ┆ ---      ┆ ---   │
+│ str   ┆ str         ┆ str      ┆ i64   │
+╞═══════╪═════════════╪══════════╪═══════╡
+│ Alice ┆ Engineering ┆ age      ┆ 25    │
+│ Bob   ┆ Marketing   ┆ age      ┆ 30    │
+│ Alice ┆ Engineering ┆ salary   ┆ 50000 │
+│ Bob   ┆ Marketing   ┆ salary   ┆ 60000 │
+└───────┴─────────────┴──────────┴───────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("PivotCityByMonth", func(t *testing.T) {
+		weather := []byte("city,month,temp\nNYC,Jan,1\nNYC,Feb,3\nLA,Jan,15\nLA,Feb,17\nNYC,Jan,3\n")
+		result, err := ReadCSVBytes(weather, CSVReadConfig{HasHeader: true}).
+			Pivot([]string{"city"}, []string{"month"}, []string{"temp"}, "mean").
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: NYC's two January readings are averaged into one cell
+		expected := `shape: (2, 3)
+┌──────┬──────┬──────┐
+│ city ┆ Jan  ┆ Feb  │
+│ ---  ┆ ---  ┆ ---  │
+│ str  ┆ f64  ┆ f64  │
+╞══════╪══════╪══════╡
+│ NYC  ┆ 2.0  ┆ 3.0  │
+│ LA   ┆ 15.0 ┆ 17.0 │
+└──────┴──────┴──────┘`
+
+		require.Equal(t, expected, result.String())
+
+		_, err = ReadCSVBytes(weather, CSVReadConfig{HasHeader: true}).
+			Pivot([]string{"city"}, []string{"month"}, []string{"temp"}, "average").
+			Collect()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `Pivot() unknown aggregation "average"`)
+	})
+
+	t.Run("UnpivotSensorColumns", func(t *testing.T) {
+		sensors := []byte("sensor,temp,humidity\ns1,21,40\ns2,19,55\n")
+		result, err := ReadCSVBytes(sensors, CSVReadConfig{HasHeader: true}).
+			Unpivot([]string{"sensor"}, nil, "metric", "reading").
+			Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		// Golden test: with no value columns given, every non-id column is unpivoted
+		expected := `shape: (4, 3)
+┌────────┬──────────┬─────────┐
+│ sensor ┆ metric   ┆ reading │
+│ ---    ┆ ---      ┆ ---     │
+│ str    ┆ str      ┆ i64     │
+╞════════╪══════════╪═════════╡
+│ s1     ┆ temp     ┆ 21      │
+│ s2     ┆ temp     ┆ 19      │
+│ s1     ┆ humidity ┆ 40      │
+│ s2     ┆ humidity ┆ 55      │
+└────────┴──────────┴─────────┘`
+
+		require.Equal(t, expected, result.String())
+	})
+}
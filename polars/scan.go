@@ -0,0 +1,159 @@
+package polars
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// ScanInto copies an executed DataFrame into dest, a pointer to a slice of structs
+// Exported fields map to columns by their `polars:"column"` tag, or by field name when untagged;
+// `polars:"-"` skips a field. A field must have exactly the column's Go type (int64, float64,
+// string, bool, ...), or a pointer to it: pointer fields receive nil for nulls, and nulls in a
+// non-pointer field are an error. All mismatches are reported together before anything is written.
+// Each column is copied out once by the column extractors and written with plain stores, so
+// reflection is only used per field, not per row (pointer fields excepted).
+// Example:
+//
+//	type Reading struct {
+//		City string  `polars:"city"`
+//		High float64 `polars:"high_temp"`
+//	}
+//	var readings []Reading
+//	err := result.ScanInto(&readings)
+func (df *DataFrame) ScanInto(dest any) error {
+	if df.handle.handle == 0 {
+		return errors.New("DataFrame must be executed before calling ScanInto()")
+	}
+	ptr := reflect.ValueOf(dest)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != reflect.Slice ||
+		ptr.Elem().Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("ScanInto: dest must be a pointer to a slice of structs, got %T", dest)
+	}
+	sliceType := ptr.Elem().Type()
+	structType := sliceType.Elem()
+
+	height, err := df.Height()
+	if err != nil {
+		return err
+	}
+
+	type binding struct {
+		field  reflect.StructField
+		column ColumnData
+	}
+	var bindings []binding
+	var mismatches []string
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, ok := scanColumnName(field)
+		if !ok {
+			continue
+		}
+
+		column, err := exportColumn(df, name, "ScanInto")
+		if err != nil {
+			mismatches = append(mismatches, fmt.Sprintf("field %s: column %q not found", field.Name, name))
+			continue
+		}
+		columnType := reflect.TypeOf(column.Values).Elem()
+		switch {
+		case field.Type == columnType:
+			if column.Valid != nil {
+				mismatches = append(mismatches, fmt.Sprintf("field %s %s: column %q has nulls (use *%s)",
+					field.Name, field.Type, name, columnType))
+				continue
+			}
+		case field.Type.Kind() == reflect.Pointer && field.Type.Elem() == columnType:
+		default:
+			mismatches = append(mismatches, fmt.Sprintf("field %s %s: column %q holds %s",
+				field.Name, field.Type, name, columnType))
+			continue
+		}
+		bindings = append(bindings, binding{field: field, column: column})
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("ScanInto: %s", strings.Join(mismatches, "; "))
+	}
+
+	out := reflect.MakeSlice(sliceType, height, height)
+	if height > 0 {
+		base := out.Index(0).Addr().UnsafePointer()
+		for _, b := range bindings {
+			if b.field.Type.Kind() == reflect.Pointer {
+				scanPointers(out, b.field.Index, b.column)
+			} else {
+				scanColumn(base, structType.Size(), b.field.Offset, b.column.Values)
+			}
+		}
+	}
+	ptr.Elem().Set(out)
+	return nil
+}
+
+// scanColumnName returns the column a struct field maps to; ok is false for skipped fields
+func scanColumnName(field reflect.StructField) (name string, ok bool) {
+	if !field.IsExported() || field.Anonymous {
+		return "", false
+	}
+	tag := field.Tag.Get("polars")
+	switch tag {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	default:
+		return tag, true
+	}
+}
+
+// scanColumn stores a typed column into the field at offset of each struct in a slice
+func scanColumn(base unsafe.Pointer, stride, offset uintptr, values any) {
+	switch values := values.(type) {
+	case []int8:
+		scanValues(base, stride, offset, values)
+	case []int16:
+		scanValues(base, stride, offset, values)
+	case []int32:
+		scanValues(base, stride, offset, values)
+	case []int64:
+		scanValues(base, stride, offset, values)
+	case []uint8:
+		scanValues(base, stride, offset, values)
+	case []uint16:
+		scanValues(base, stride, offset, values)
+	case []uint32:
+		scanValues(base, stride, offset, values)
+	case []uint64:
+		scanValues(base, stride, offset, values)
+	case []float32:
+		scanValues(base, stride, offset, values)
+	case []float64:
+		scanValues(base, stride, offset, values)
+	case []bool:
+		scanValues(base, stride, offset, values)
+	case []string:
+		scanValues(base, stride, offset, values)
+	}
+}
+
+func scanValues[T any](base unsafe.Pointer, stride, offset uintptr, values []T) {
+	for i, v := range values {
+		*(*T)(unsafe.Add(base, uintptr(i)*stride+offset)) = v
+	}
+}
+
+// scanPointers fills a pointer field, leaving it nil where the column is null
+func scanPointers(out reflect.Value, index []int, column ColumnData) {
+	values := reflect.ValueOf(column.Values)
+	for i := 0; i < out.Len(); i++ {
+		if column.IsNull(i) {
+			continue
+		}
+		value := reflect.New(values.Type().Elem())
+		value.Elem().Set(values.Index(i))
+		out.Index(i).FieldByIndex(index).Set(value)
+	}
+}
@@ -0,0 +1,78 @@
+package polars
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestScanInto demonstrates scanning rows into tagged Go structs
+func TestScanInto(t *testing.T) {
+	type employee struct {
+		Name       string `polars:"name"`
+		Age        int64  `polars:"age"`
+		Department string `polars:"department"`
+		Salary     int64  `polars:"salary"`
+		Note       string `polars:"-"`
+	}
+
+	t.Run("TaggedFields", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Limit(2).Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		var employees []employee
+		require.NoError(t, result.ScanInto(&employees))
+		require.Equal(t, []employee{
+			{Name: "Alice", Age: 25, Department: "Engineering", Salary: 50000},
+			{Name: "Bob", Age: 30, Department: "Marketing", Salary: 60000},
+		}, employees)
+	})
+
+	t.Run("NullsIntoPointers", func(t *testing.T) {
+		collected, err := ReadCSV("../testdata/sample.csv").Select("name", "age").Limit(1).Collect()
+		require.NoError(t, err)
+		defer collected.Release()
+		withNull, err := collected.addNullRowForTesting().Collect()
+		require.NoError(t, err)
+
+		type person struct {
+			Name *string `polars:"name"`
+			Age  *int64  `polars:"age"`
+		}
+		var people []person
+		require.NoError(t, withNull.ScanInto(&people))
+		require.Len(t, people, 2)
+		require.Equal(t, "Alice", *people[0].Name)
+		require.Equal(t, int64(25), *people[0].Age)
+		require.Nil(t, people[1].Name)
+		require.Nil(t, people[1].Age)
+
+		// Nulls cannot go into non-pointer fields
+		var plain []struct {
+			Age int64 `polars:"age"`
+		}
+		err = withNull.ScanInto(&plain)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `field Age int64: column "age" has nulls (use *int64)`)
+	})
+
+	t.Run("Mismatches", func(t *testing.T) {
+		result, err := ReadCSV("../testdata/sample.csv").Collect()
+		require.NoError(t, err)
+		defer result.Release()
+
+		var wrong []struct {
+			Name  int64   `polars:"name"`
+			Age   float64 `polars:"age"`
+			Bonus int64   `polars:"bonus"`
+		}
+		err = result.ScanInto(&wrong)
+		require.Error(t, err)
+		require.Equal(t, `ScanInto: field Name int64: column "name" holds string; `+
+			`field Age float64: column "age" holds int64; field Bonus: column "bonus" not found`, err.Error())
+
+		var notSlice employee
+		require.Error(t, result.ScanInto(&notSlice))
+	})
+}
@@ -4,6 +4,7 @@ package polars
 #include "firn.h"
 */
 import "C"
+import "unsafe"
 
 // SortField represents a column to sort by with direction and nulls ordering
 type SortField struct {
@@ -64,6 +65,146 @@ func DescNullsFirst(column string) SortField {
 	}
 }
 
+// SortExpr represents an expression to sort by with direction and nulls ordering
+type SortExpr struct {
+	Expr          *ExprNode
+	Direction     SortDirection
+	NullsOrdering NullsOrdering
+}
+
+// AscExpr creates a SortExpr for ascending order with nulls last (default)
+func AscExpr(expr *ExprNode) SortExpr {
+	return SortExpr{
+		Expr:          expr,
+		Direction:     Ascending,
+		NullsOrdering: NullsLast,
+	}
+}
+
+// DescExpr creates a SortExpr for descending order with nulls last (default)
+func DescExpr(expr *ExprNode) SortExpr {
+	return SortExpr{
+		Expr:          expr,
+		Direction:     Descending,
+		NullsOrdering: NullsLast,
+	}
+}
+
+// AscExprNullsFirst creates a SortExpr for ascending order with nulls first
+func AscExprNullsFirst(expr *ExprNode) SortExpr {
+	return SortExpr{
+		Expr:          expr,
+		Direction:     Ascending,
+		NullsOrdering: NullsFirst,
+	}
+}
+
+// DescExprNullsFirst creates a SortExpr for descending order with nulls first
+func DescExprNullsFirst(expr *ExprNode) SortExpr {
+	return SortExpr{
+		Expr:          expr,
+		Direction:     Descending,
+		NullsOrdering: NullsFirst,
+	}
+}
+
+// SortByExpr sorts the DataFrame by computed expressions, each with its own
+// direction and nulls ordering
+// Example: df.SortByExpr(DescExprNullsFirst(Col("salary").Div(Col("age"))), AscExpr(Col("name")))
+func (df *DataFrame) SortByExpr(keys ...SortExpr) *DataFrame {
+	if len(keys) == 0 {
+		return df.appendErrOp("SortByExpr() requires at least one sort expression")
+	}
+	for i, key := range keys {
+		if key.Expr == nil || key.Expr.consumed() {
+			return df.appendErrOpf("SortByExpr(): sort expression %d is nil or already used", i)
+		}
+	}
+
+	// Push key expressions onto the stack in order, then the sort itself
+	for _, key := range keys {
+		for exprOp := range key.Expr.consumeOps() {
+			df.appendOps("SortByExpr()", exprOp)
+		}
+	}
+
+	df.appendOps("SortByExpr()", Operation{
+		opcode: OpSortExpr,
+		args: func() unsafe.Pointer {
+			cKeys := make([]C.SortKeyOptions, len(keys))
+			for i, key := range keys {
+				cKeys[i] = C.SortKeyOptions{
+					direction:      C.SortDirection(key.Direction),
+					nulls_ordering: C.NullsOrdering(key.NullsOrdering),
+				}
+			}
+			return unsafe.Pointer(&C.SortExprArgs{
+				keys:      &cKeys[0],
+				key_count: C.int(len(keys)),
+			})
+		},
+	})
+
+	return df
+}
+
+// TopK keeps the k rows with the largest values of a column, largest first
+// It is a partial sort, cheaper than SortBy + Limit on large inputs; nulls rank last.
+// Example: df.TopK(10, "salary") keeps the ten highest salaries
+func (df *DataFrame) TopK(k int, by string) *DataFrame {
+	return df.topK("TopK()", k, false, []*ExprNode{Col(by)})
+}
+
+// BottomK keeps the k rows with the smallest values of a column, smallest first
+func (df *DataFrame) BottomK(k int, by string) *DataFrame {
+	return df.topK("BottomK()", k, true, []*ExprNode{Col(by)})
+}
+
+// TopKBy keeps the k rows with the largest values of computed keys; later keys break ties
+// Example: df.TopKBy(5, Col("salary").Div(Col("age")))
+func (df *DataFrame) TopKBy(k int, by ...*ExprNode) *DataFrame {
+	return df.topK("TopKBy()", k, false, by)
+}
+
+// BottomKBy keeps the k rows with the smallest values of computed keys; later keys break ties
+func (df *DataFrame) BottomKBy(k int, by ...*ExprNode) *DataFrame {
+	return df.topK("BottomKBy()", k, true, by)
+}
+
+// topK pushes the key expressions onto the stack followed by the selection itself
+func (df *DataFrame) topK(method string, k int, bottom bool, by []*ExprNode) *DataFrame {
+	if k <= 0 {
+		return df.appendErrOpf("%s requires k > 0", method)
+	}
+	if len(by) == 0 {
+		return df.appendErrOpf("%s requires at least one key expression", method)
+	}
+	for i, key := range by {
+		if key == nil || key.consumed() {
+			return df.appendErrOpf("%s: key expression %d is nil or already used", method, i)
+		}
+	}
+
+	for _, key := range by {
+		for exprOp := range key.consumeOps() {
+			df.appendOps(method, exprOp)
+		}
+	}
+
+	df.appendOps(method, Operation{
+		opcode: OpTopK,
+		args: func() unsafe.Pointer {
+			return unsafe.Pointer(&C.TopKArgs{
+				k:         C.size_t(k),
+				key_count: C.int(len(by)),
+				bottom:    C.bool(bottom),
+			})
+		},
+	})
+
+	return df
+}
+
 // String returns a string representation of the sort direction
 func (d SortDirection) String() string {
 	switch d {
@@ -10,6 +10,8 @@ const (
 	FamilyString   = 0x0002_0000 // 0x0002_XXXX
 	FamilyTemporal = 0x0003_0000 // 0x0003_XXXX
 	FamilyBoolean  = 0x0004_0000 // 0x0004_XXXX
+	FamilyList     = 0x0005_0000 // 0x0005_FFVV: inner type family FF, variant VV
+	FamilyStruct   = 0x0006_0000 // 0x0006_XXXX
 )
 
 // DataType constants using bit-packed encoding
@@ -41,4 +43,53 @@ const (
 	
 	// Boolean (0x0004_XXXX)
 	Boolean DataType = FamilyBoolean | 0x0001
+
+	// Struct (0x0006_XXXX) - names the family of struct columns such as nested JSON objects;
+	// it carries no fields, so Cast, SchemaOverrides and dtype selectors reject it
+	Struct DataType = FamilyStruct | 0x0001
 )
+
+// List returns the data type of a list column whose elements have the inner type
+// The inner type must not itself be a List.
+// Usage: Col("scores").Cast(List(Float64))
+func List(inner DataType) DataType {
+	family, variant := uint32(inner)>>16, uint32(inner)&0xFFFF
+	return DataType(FamilyList | family<<8 | variant&0xFF)
+}
+
+// Selector picks columns by data type, letting operations such as
+// UnpivotWithOptions choose columns without listing them by name
+type Selector struct {
+	dtypes []DataType
+}
+
+// DtypeSelector creates a Selector matching columns of any of the given data types
+// Usage: DtypeSelector(Float32, Float64)
+func DtypeSelector(dtypes ...DataType) Selector {
+	return Selector{dtypes: dtypes}
+}
+
+// IntegerSelector matches all signed and unsigned integer columns
+func IntegerSelector() Selector {
+	return DtypeSelector(Int8, Int16, Int32, Int64, UInt8, UInt16, UInt32, UInt64)
+}
+
+// FloatSelector matches all floating point columns
+func FloatSelector() Selector {
+	return DtypeSelector(Float32, Float64)
+}
+
+// NumericSelector matches all integer and floating point columns
+func NumericSelector() Selector {
+	return DtypeSelector(append(IntegerSelector().dtypes, FloatSelector().dtypes...)...)
+}
+
+// StringSelector matches all string columns
+func StringSelector() Selector {
+	return DtypeSelector(String)
+}
+
+// IsEmpty reports whether the selector matches no data types (the zero value)
+func (s Selector) IsEmpty() bool {
+	return len(s.dtypes) == 0
+}
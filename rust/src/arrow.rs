@@ -0,0 +1,127 @@
+use crate::RawStr;
+use polars::prelude::{CompatLevel, DataFrame, PolarsResult};
+use polars_arrow::array::{Array, StructArray};
+use polars_arrow::datatypes::{ArrowDataType, Field as ArrowField};
+use polars_arrow::ffi::{
+    export_array_to_c, export_field_to_c, export_iterator, ArrowArray, ArrowArrayStream, ArrowSchema,
+};
+use std::os::raw::c_int;
+
+/// Arrow struct dtype describing a DataFrame's columns
+pub(crate) fn arrow_struct_dtype(df: &DataFrame, compat: CompatLevel) -> ArrowDataType {
+    let fields: Vec<ArrowField> = df
+        .schema()
+        .iter_fields()
+        .map(|field| field.to_arrow(compat))
+        .collect();
+    ArrowDataType::Struct(fields)
+}
+
+/// Convert a DataFrame (or a slice of one) into a single Arrow StructArray
+pub(crate) fn dataframe_to_struct_array(
+    df: &DataFrame,
+    dtype: &ArrowDataType,
+    compat: CompatLevel,
+) -> PolarsResult<Box<dyn Array>> {
+    let arrays: Vec<Box<dyn Array>> = df
+        .get_columns()
+        .iter()
+        .map(|column| {
+            column
+                .as_materialized_series()
+                .rechunk()
+                .to_arrow(0, compat)
+        })
+        .collect();
+
+    let struct_array = StructArray::try_new(dtype.clone(), arrays, None)?;
+    Ok(Box::new(struct_array))
+}
+
+/// Export a DataFrame as an Arrow C stream of record batches with at most batch_size rows
+/// The stream owns a clone of the DataFrame so the Go handle may be released independently
+#[no_mangle]
+pub extern "C" fn dataframe_to_arrow_stream(
+    handle: usize,
+    batch_size: usize,
+    out: *mut ArrowArrayStream,
+) -> c_int {
+    if handle == 0 || out.is_null() || batch_size == 0 {
+        return 1;
+    }
+
+    let df = unsafe { &*(handle as *const DataFrame) }.clone();
+    let dtype = arrow_struct_dtype(&df, CompatLevel::newest());
+    let field = ArrowField::new("".into(), dtype.clone(), false);
+
+    let height = df.height();
+    let batches = (0..height).step_by(batch_size).map(move |offset| {
+        let batch = df.slice(offset as i64, batch_size);
+        dataframe_to_struct_array(&batch, &dtype, CompatLevel::newest())
+    });
+
+    let stream = export_iterator(Box::new(batches), field);
+    unsafe { std::ptr::write(out, stream) };
+    0
+}
+
+/// Export a whole DataFrame as a single Arrow struct array with contiguous columns
+/// Uses the oldest compat level so strings are plain LargeUtf8 rather than views, which
+/// keeps the buffers simple for consumers that read them directly.
+#[no_mangle]
+pub extern "C" fn dataframe_to_arrow_array(
+    handle: usize,
+    out_schema: *mut ArrowSchema,
+    out_array: *mut ArrowArray,
+) -> c_int {
+    if handle == 0 || out_schema.is_null() || out_array.is_null() {
+        return 1;
+    }
+
+    let df = unsafe { &*(handle as *const DataFrame) };
+    let dtype = arrow_struct_dtype(df, CompatLevel::oldest());
+    let array = match dataframe_to_struct_array(df, &dtype, CompatLevel::oldest()) {
+        Ok(array) => array,
+        Err(_) => return 2,
+    };
+
+    let field = ArrowField::new("".into(), dtype, false);
+    unsafe {
+        std::ptr::write(out_schema, export_field_to_c(&field));
+        std::ptr::write(out_array, export_array_to_c(array));
+    }
+    0
+}
+
+/// Export a single named column as a contiguous Arrow array
+/// Returns 2 when the column does not exist.
+#[no_mangle]
+pub extern "C" fn dataframe_column_to_arrow(
+    handle: usize,
+    name: RawStr,
+    out_schema: *mut ArrowSchema,
+    out_array: *mut ArrowArray,
+) -> c_int {
+    if handle == 0 || out_schema.is_null() || out_array.is_null() {
+        return 1;
+    }
+    let name = match unsafe { name.as_str() } {
+        Ok(name) => name,
+        Err(_) => return 1,
+    };
+
+    let df = unsafe { &*(handle as *const DataFrame) };
+    let column = match df.column(name) {
+        Ok(column) => column,
+        Err(_) => return 2,
+    };
+    let series = column.as_materialized_series().rechunk();
+    let field = series.field().to_arrow(CompatLevel::oldest());
+    let array = series.to_arrow(0, CompatLevel::oldest());
+
+    unsafe {
+        std::ptr::write(out_schema, export_field_to_c(&field));
+        std::ptr::write(out_array, export_array_to_c(array));
+    }
+    0
+}
@@ -0,0 +1,57 @@
+use polars::prelude::{DataFrame, LazyFrame, PolarsResult};
+use std::sync::atomic::{AtomicBool, Ordering};
+use std::thread;
+use std::time::Duration;
+
+/// How often a cancellable collect checks its token while the query runs
+const CANCEL_POLL_INTERVAL: Duration = Duration::from_millis(5);
+
+/// Create a cancellation token for a cancellable collect
+/// The token is a heap-allocated flag owned by the caller, who must free it with
+/// free_cancel_token once the collect has returned.
+#[no_mangle]
+pub extern "C" fn new_cancel_token() -> usize {
+    Box::into_raw(Box::new(AtomicBool::new(false))) as usize
+}
+
+/// Request cancellation of the collect watching this token
+/// Safe to call from any thread while the collect is running.
+#[no_mangle]
+pub extern "C" fn cancel_token(token: usize) {
+    if token != 0 {
+        let flag = unsafe { &*(token as *const AtomicBool) };
+        flag.store(true, Ordering::Release);
+    }
+}
+
+/// Free a token created by new_cancel_token
+#[no_mangle]
+pub extern "C" fn free_cancel_token(token: usize) {
+    if token != 0 {
+        unsafe { drop(Box::from_raw(token as *mut AtomicBool)) };
+    }
+}
+
+/// Collect a LazyFrame on a background thread, stopping it if the token is cancelled
+/// Returns None when cancelled. Polars checks for cancellation between its execution
+/// steps, so a cancelled query stops at the next step rather than immediately.
+pub(crate) fn collect_cancellable(
+    lazy_frame: LazyFrame,
+    token: usize,
+) -> Option<PolarsResult<DataFrame>> {
+    let flag = unsafe { &*(token as *const AtomicBool) };
+    let query = match lazy_frame.collect_concurrently() {
+        Ok(query) => query,
+        Err(e) => return Some(Err(e)),
+    };
+    loop {
+        if let Some(result) = query.fetch() {
+            return Some(result);
+        }
+        if flag.load(Ordering::Acquire) {
+            query.cancel();
+            return None;
+        }
+        thread::sleep(CANCEL_POLL_INTERVAL);
+    }
+}
@@ -1,18 +1,25 @@
+use crate::cancel::collect_cancellable;
+use crate::execution::run_operations;
+use crate::handles::unregister_handle_ref;
 use crate::{
-    execute_expr_ops, ContextType, ExecutionContext, FfiResult, JoinArgs, JoinType, LimitArgs, 
-    NullsOrdering, Operation, PolarsHandle, QueryArgs, RawStr, SortArgs, SortDirection, 
-    ERROR_INVALID_UTF8, ERROR_NULL_ARGS, ERROR_NULL_HANDLE, ERROR_POLARS_OPERATION,
+    execute_expr_ops, AsofJoinArgs, CollectArgs, ContextType, ExecutionContext, FetchArgs, FfiResult, GatherEveryArgs, JoinArgs, JoinType, LimitArgs, 
+    NullsOrdering, Operation, PolarsHandle, QueryArgs, RawStr, SortArgs, SortDirection, SortExprArgs,
+    SortField, TopKArgs, UniqueArgs, CorrMatrixArgs, DropArgs, DropNullsArgs, RenameArgs, UnnestArgs, ValueCountsArgs, SampleArgs, SliceArgs, CORR_METHOD_PEARSON, CORR_METHOD_SPEARMAN, UNIQUE_KEEP_ANY, UNIQUE_KEEP_FIRST, UNIQUE_KEEP_LAST, UNIQUE_KEEP_NONE,
+    ASOF_STRATEGY_BACKWARD, ASOF_STRATEGY_FORWARD, ASOF_STRATEGY_NEAREST,
+    ERROR_CANCELLED, ERROR_INVALID_UTF8, ERROR_NULL_ARGS, ERROR_NULL_HANDLE, ERROR_POLARS_OPERATION,
 };
-use polars::prelude::{DataFrame, LazyFrame, LazyGroupBy, Expr, col, len, CsvWriter, 
-    concat, UnionArgs, SortMultipleOptions, Series, Column, PolarsError, JoinArgs as PolarJoinArgs, JoinCoalesce,
-    IntoLazy, SerWriter};
+use polars::prelude::{DataFrame, LazyFrame, LazyGroupBy, Expr, AggExpr, all, col, len, CsvWriter, 
+    concat, concat_lf_diagonal, UnionArgs, SortMultipleOptions, Series, Column, PolarsError, JoinArgs as PolarJoinArgs, JoinCoalesce,
+    IntoLazy, Schema, SerWriter, UniqueKeepStrategy, lit, when,
+    pearson_corr, spearman_rank_corr, DataType, IdxSize, AnyValue, AsOfOptions, AsofStrategy,
+    PlSmallStr, Selector};
 use polars_sql::SQLContext;
 use std::ffi::CString;
 use std::os::raw::{c_char, c_int};
 use std::ptr;
 
 /// Helper function to convert RawStr array to Vec<String>
-unsafe fn raw_str_array_to_vec(
+pub(crate) unsafe fn raw_str_array_to_vec(
     raw_strs: *const RawStr,
     count: usize,
 ) -> std::result::Result<Vec<String>, &'static str> {
@@ -33,6 +40,48 @@ unsafe fn raw_str_array_to_vec(
     Ok(result)
 }
 
+/// Helper function to convert an optional RawStr array to Vec<String>
+/// A null pointer or zero count yields an empty Vec
+pub(crate) unsafe fn optional_raw_str_array_to_vec(
+    raw_strs: *const RawStr,
+    count: usize,
+) -> std::result::Result<Vec<String>, &'static str> {
+    if raw_strs.is_null() || count == 0 {
+        return Ok(Vec::new());
+    }
+    raw_str_array_to_vec(raw_strs, count)
+}
+
+/// Resolve a handle into an owned LazyFrame for operations that behave the same
+/// on DataFrame and LazyFrame inputs. LazyGroupBy handles are rejected.
+pub(crate) fn lazy_frame_from_handle(
+    handle: PolarsHandle,
+    op_name: &str,
+) -> std::result::Result<LazyFrame, FfiResult> {
+    if handle.handle == 0 {
+        return Err(FfiResult::error(ERROR_NULL_HANDLE, "Handle cannot be null"));
+    }
+
+    match handle.get_context_type() {
+        Some(ContextType::DataFrame) => {
+            let df = unsafe { &*(handle.handle as *const DataFrame) };
+            Ok(df.clone().lazy())
+        }
+        Some(ContextType::LazyFrame) => {
+            let lazy_frame = unsafe { &*(handle.handle as *const LazyFrame) };
+            Ok(lazy_frame.clone())
+        }
+        Some(ContextType::LazyGroupBy) => Err(FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!(
+                "Cannot call {}() on grouped data. Call agg() first to resolve grouping.",
+                op_name
+            ),
+        )),
+        None => Err(FfiResult::error(ERROR_POLARS_OPERATION, "Invalid context type")),
+    }
+}
+
 /// Arguments for select operations
 #[repr(C)]
 pub struct SelectArgs {
@@ -40,13 +89,25 @@ pub struct SelectArgs {
     pub column_count: usize,    // Number of columns
 }
 
+/// Arguments for group by operations
+#[repr(C)]
+pub struct GroupByArgs {
+    pub maintain_order: bool, // Emit groups in first-seen order instead of an arbitrary order
+}
+
 /// Arguments for concatenation operations
 #[repr(C)]
 pub struct ConcatArgs {
     pub handles: *const usize, // Array of DataFrame handles
     pub count: usize,          // Number of DataFrames to concatenate
+    pub how: u32,              // CONCAT_HOW_* strategy
 }
 
+/// Concat strategies (must match CONCAT_HOW_* in firn.h)
+pub const CONCAT_HOW_VERTICAL: u32 = 0;
+pub const CONCAT_HOW_HORIZONTAL: u32 = 1;
+pub const CONCAT_HOW_DIAGONAL: u32 = 2;
+
 /// Arguments for filter operations with expressions
 #[repr(C)]
 pub struct FilterExprArgs {
@@ -125,6 +186,14 @@ pub fn dispatch_group_by(handle: PolarsHandle, context: &ExecutionContext) -> Ff
 
     // Collect ALL expressions from the stack (consume them all)
     let group_exprs: Vec<_> = expr_stack.drain(..).collect();
+    let args = unsafe { &*(context.operation_args as *const GroupByArgs) };
+    let group_by = |lazy_frame: LazyFrame| {
+        if args.maintain_order {
+            lazy_frame.group_by_stable(group_exprs)
+        } else {
+            lazy_frame.group_by(group_exprs)
+        }
+    };
 
     // Get context type and perform operation based on current context
     let context_type = match handle.get_context_type() {
@@ -136,13 +205,13 @@ pub fn dispatch_group_by(handle: PolarsHandle, context: &ExecutionContext) -> Ff
         ContextType::DataFrame => {
             // Convert DataFrame to LazyFrame and group by expressions
             let df = unsafe { &*(handle.handle as *const DataFrame) };
-            let lazy_group_by = df.clone().lazy().group_by(group_exprs);
+            let lazy_group_by = group_by(df.clone().lazy());
             FfiResult::success_lazy_group_by(lazy_group_by)
         }
         ContextType::LazyFrame => {
             // Chain group by operation on existing LazyFrame
             let lazy_frame = unsafe { &*(handle.handle as *const LazyFrame) };
-            let lazy_group_by = lazy_frame.clone().group_by(group_exprs);
+            let lazy_group_by = group_by(lazy_frame.clone());
             FfiResult::success_lazy_group_by(lazy_group_by)
         }
         ContextType::LazyGroupBy => {
@@ -155,6 +224,68 @@ pub fn dispatch_group_by(handle: PolarsHandle, context: &ExecutionContext) -> Ff
     }
 }
 
+/// Name suffix for an unaliased aggregation, used to disambiguate output columns
+fn agg_suffix(expr: &Expr) -> Option<&'static str> {
+    match expr {
+        Expr::Agg(agg) => Some(match agg {
+            AggExpr::Min { .. } => "min",
+            AggExpr::Max { .. } => "max",
+            AggExpr::Median(_) => "median",
+            AggExpr::NUnique(_) => "n_unique",
+            AggExpr::First(_) => "first",
+            AggExpr::Last(_) => "last",
+            AggExpr::Mean(_) => "mean",
+            AggExpr::Count(_, _) => "count",
+            AggExpr::Quantile { .. } => "quantile",
+            AggExpr::Sum(_) => "sum",
+            AggExpr::Std(_, _) => "std",
+            AggExpr::Var(_, _) => "var",
+            _ => "agg",
+        }),
+        _ => None,
+    }
+}
+
+/// Ensure aggregation outputs have distinct names
+/// Unaliased aggregations that collide are suffixed with the aggregation name
+/// (salary -> salary_min, salary_max); any remaining collision is an error
+fn disambiguate_agg_names(exprs: Vec<Expr>) -> std::result::Result<Vec<Expr>, String> {
+    let output_name = |expr: &Expr| expr.clone().meta().output_name().ok().map(|n| n.to_string());
+    let count_names = |exprs: &[Expr]| {
+        let mut counts = std::collections::HashMap::new();
+        for name in exprs.iter().filter_map(|e| output_name(e)) {
+            *counts.entry(name).or_insert(0usize) += 1;
+        }
+        counts
+    };
+
+    let counts = count_names(&exprs);
+    let exprs: Vec<Expr> = exprs
+        .into_iter()
+        .map(|expr| match (output_name(&expr), agg_suffix(&expr)) {
+            (Some(name), Some(suffix)) if counts.get(&name).copied().unwrap_or(0) > 1 => {
+                expr.alias(format!("{}_{}", name, suffix).as_str())
+            }
+            _ => expr,
+        })
+        .collect();
+
+    let mut duplicates: Vec<String> = count_names(&exprs)
+        .into_iter()
+        .filter(|(_, count)| *count > 1)
+        .map(|(name, _)| name)
+        .collect();
+    if !duplicates.is_empty() {
+        duplicates.sort();
+        return Err(format!(
+            "Agg() produces duplicate output column(s) {:?}; give each aggregation a distinct Alias()",
+            duplicates
+        ));
+    }
+
+    Ok(exprs)
+}
+
 /// Dispatch function for aggregation operations on LazyGroupBy
 pub fn dispatch_agg(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
     if handle.handle == 0 {
@@ -187,6 +318,12 @@ pub fn dispatch_agg(handle: PolarsHandle, context: &ExecutionContext) -> FfiResu
     // Take all expressions from the stack (consume them all)
     let agg_exprs = expr_stack.drain(..).collect::<Vec<_>>();
 
+    // Same-column aggregations (e.g. min and max of salary) would otherwise collide
+    let agg_exprs = match disambiguate_agg_names(agg_exprs) {
+        Ok(exprs) => exprs,
+        Err(msg) => return FfiResult::error(ERROR_POLARS_OPERATION, &msg),
+    };
+
     // Apply aggregations to LazyGroupBy
     let lazy_group_by = unsafe { &*(handle.handle as *const LazyGroupBy) };
     let result_lazy_frame = lazy_group_by.clone().agg(agg_exprs);
@@ -213,36 +350,20 @@ pub fn dispatch_sort(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
     }
 
     // Convert SortField array to column names, directions, and nulls ordering
-    let sort_fields = unsafe { std::slice::from_raw_parts(args.fields, args.field_count as usize) };
-
-    let mut columns = Vec::new();
-    let mut descending = Vec::new();
-    let mut nulls_last = Vec::new();
-
-    for field in sort_fields {
-        // Convert column name
-        let column_name = match unsafe {
-            std::str::from_utf8(std::slice::from_raw_parts(
-                field.column.data as *const u8,
-                field.column.len,
-            ))
-        } {
-            Ok(name) => name.to_string(),
-            Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in column name"),
+    let (columns, descending, nulls_last) =
+        match unsafe { sort_fields_to_vecs(args.fields, args.field_count as usize) } {
+            Ok(vecs) => vecs,
+            Err(err) => return err,
         };
 
-        columns.push(column_name);
-        descending.push(matches!(field.direction, SortDirection::Descending));
-        nulls_last.push(matches!(field.nulls_ordering, NullsOrdering::Last));
-    }
-
     match context_type {
         ContextType::DataFrame => {
             let df = unsafe { &*(handle.handle as *const DataFrame) };
             // Use the newer sort API with SortMultipleOptions
             let sort_options = SortMultipleOptions::default()
                 .with_order_descending_multi(descending.clone())
-                .with_nulls_last_multi(nulls_last.clone());
+                .with_nulls_last_multi(nulls_last.clone())
+                .with_maintain_order(args.maintain_order);
             let sorted_df = df.clone().sort(columns, sort_options);
 
             match sorted_df {
@@ -255,7 +376,8 @@ pub fn dispatch_sort(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
             // Use the newer sort API with SortMultipleOptions
             let sort_options = SortMultipleOptions::default()
                 .with_order_descending_multi(descending.clone())
-                .with_nulls_last_multi(nulls_last.clone());
+                .with_nulls_last_multi(nulls_last.clone())
+                .with_maintain_order(args.maintain_order);
             let sorted_lazy = lazy_frame.clone().sort(columns, sort_options);
 
             FfiResult::success_lazy(sorted_lazy)
@@ -270,6 +392,369 @@ pub fn dispatch_sort(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
     }
 }
 
+/// Convert a SortField array to column names, descending flags, and nulls-last flags
+unsafe fn sort_fields_to_vecs(
+    fields: *const SortField,
+    count: usize,
+) -> Result<(Vec<String>, Vec<bool>, Vec<bool>), FfiResult> {
+    let sort_fields = std::slice::from_raw_parts(fields, count);
+
+    let mut columns = Vec::with_capacity(count);
+    let mut descending = Vec::with_capacity(count);
+    let mut nulls_last = Vec::with_capacity(count);
+
+    for field in sort_fields {
+        // Convert column name
+        let column_name = match std::str::from_utf8(std::slice::from_raw_parts(
+            field.column.data as *const u8,
+            field.column.len,
+        )) {
+            Ok(name) => name.to_string(),
+            Err(_) => {
+                return Err(FfiResult::error(
+                    ERROR_INVALID_UTF8,
+                    "Invalid UTF-8 in column name",
+                ))
+            }
+        };
+
+        columns.push(column_name);
+        descending.push(matches!(field.direction, SortDirection::Descending));
+        nulls_last.push(matches!(field.nulls_ordering, NullsOrdering::Last));
+    }
+
+    Ok((columns, descending, nulls_last))
+}
+
+/// Dispatch function for unique operations
+/// When an ordering is given, rows are stably sorted first so the kept row per key is deterministic
+pub fn dispatch_unique(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "unique") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const UniqueArgs) };
+
+    let subset = match unsafe { optional_raw_str_array_to_vec(args.subset, args.subset_count) } {
+        Ok(cols) if cols.is_empty() => None,
+        Ok(cols) => Some(cols),
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+
+    let keep = match args.keep {
+        UNIQUE_KEEP_FIRST => UniqueKeepStrategy::First,
+        UNIQUE_KEEP_LAST => UniqueKeepStrategy::Last,
+        UNIQUE_KEEP_ANY => UniqueKeepStrategy::Any,
+        UNIQUE_KEEP_NONE => UniqueKeepStrategy::None,
+        other => {
+            return FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!("Unknown unique keep strategy {}", other),
+            )
+        }
+    };
+
+    if args.order.is_null() || args.order_count <= 0 {
+        return FfiResult::success_lazy(lazy_frame.unique_stable(subset, keep));
+    }
+
+    let (columns, descending, nulls_last) =
+        match unsafe { sort_fields_to_vecs(args.order, args.order_count as usize) } {
+            Ok(vecs) => vecs,
+            Err(err) => return err,
+        };
+    let sort_options = SortMultipleOptions::default()
+        .with_order_descending_multi(descending)
+        .with_nulls_last_multi(nulls_last)
+        .with_maintain_order(true);
+
+    FfiResult::success_lazy(
+        lazy_frame
+            .sort(columns, sort_options)
+            .unique_stable(subset, keep),
+    )
+}
+
+/// Dispatch function for dropping rows that contain nulls
+pub fn dispatch_drop_nulls(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "drop_nulls") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const DropNullsArgs) };
+
+    let subset = match unsafe { optional_raw_str_array_to_vec(args.subset, args.subset_count) } {
+        Ok(cols) if cols.is_empty() => None,
+        Ok(cols) => Some(cols.iter().map(|name| col(name)).collect()),
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+
+    FfiResult::success_lazy(lazy_frame.drop_nulls(subset))
+}
+
+/// Dispatch function for removing columns
+/// Every column must exist, so a typo fails loudly instead of leaving the column in place
+pub fn dispatch_drop(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "drop") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const DropArgs) };
+
+    let columns = match unsafe { raw_str_array_to_vec(args.columns, args.column_count) } {
+        Ok(cols) => cols,
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+
+    let schema = match lazy_frame.clone().collect_schema() {
+        Ok(schema) => schema,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+    if let Some(missing) = columns.iter().find(|name| schema.get(name.as_str()).is_none()) {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!("Drop: column \"{}\" not found", missing),
+        );
+    }
+
+    FfiResult::success_lazy(lazy_frame.drop(columns))
+}
+
+/// Dispatch function for renaming columns
+/// Every source column must exist and no new name may collide with a column that keeps its name
+pub fn dispatch_rename(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "rename") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const RenameArgs) };
+
+    let schema = match lazy_frame.clone().collect_schema() {
+        Ok(schema) => schema,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+
+    let (existing, renamed) = if args.count == 0 {
+        // No explicit mapping: add the prefix and suffix to every column
+        let (prefix, suffix) = match unsafe { (args.prefix.as_str(), args.suffix.as_str()) } {
+            (Ok(prefix), Ok(suffix)) => (prefix, suffix),
+            _ => {
+                return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in prefix or suffix")
+            }
+        };
+        let existing: Vec<String> = schema.iter_names().map(|name| name.to_string()).collect();
+        let renamed = existing
+            .iter()
+            .map(|name| format!("{}{}{}", prefix, name, suffix))
+            .collect();
+        (existing, renamed)
+    } else {
+        let existing = match unsafe { raw_str_array_to_vec(args.existing, args.count) } {
+            Ok(names) => names,
+            Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+        };
+        let renamed = match unsafe { raw_str_array_to_vec(args.renamed, args.count) } {
+            Ok(names) => names,
+            Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+        };
+        (existing, renamed)
+    };
+
+    if let Some(missing) = existing.iter().find(|name| schema.get(name.as_str()).is_none()) {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!("Rename: column \"{}\" not found", missing),
+        );
+    }
+
+    let kept = schema
+        .iter_names()
+        .filter(|name| !existing.iter().any(|old| old.as_str() == name.as_str()));
+    let mut taken: Vec<&str> = kept.map(|name| name.as_str()).collect();
+    for (old, name) in existing.iter().zip(renamed.iter()) {
+        if taken.contains(&name.as_str()) {
+            return FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!(
+                    "Rename: cannot rename \"{}\" to \"{}\": column already exists",
+                    old, name
+                ),
+            );
+        }
+        taken.push(name.as_str());
+    }
+
+    FfiResult::success_lazy(lazy_frame.rename(existing, renamed, true))
+}
+
+/// Dispatch function for correlation matrices across all numeric columns
+/// Produces an NxN frame whose first "column" column labels each row
+pub fn dispatch_corr_matrix(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "corr_matrix") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const CorrMatrixArgs) };
+
+    let schema = match lazy_frame.clone().collect_schema() {
+        Ok(schema) => schema,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+    let columns: Vec<String> = schema
+        .iter()
+        .filter(|(_, dtype)| dtype.is_numeric())
+        .map(|(name, _)| name.to_string())
+        .collect();
+    if columns.is_empty() {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            "CorrMatrix requires at least one numeric column",
+        );
+    }
+
+    let corr = |a: &str, b: &str| -> Result<Expr, FfiResult> {
+        let expr = match args.method {
+            CORR_METHOD_PEARSON => pearson_corr(col(a), col(b), 1),
+            CORR_METHOD_SPEARMAN => spearman_rank_corr(col(a), col(b), 1, false),
+            other => {
+                return Err(FfiResult::error(
+                    ERROR_POLARS_OPERATION,
+                    &format!("Unknown correlation method {}", other),
+                ))
+            }
+        };
+        Ok(expr.cast(DataType::Float64))
+    };
+
+    // Compute the upper triangle in a single pass, then mirror it
+    let n = columns.len();
+    let mut pair_exprs = Vec::with_capacity(n * (n + 1) / 2);
+    for i in 0..n {
+        for j in i..n {
+            match corr(&columns[i], &columns[j]) {
+                Ok(expr) => pair_exprs.push(expr.alias(format!("{}_{}", i, j))),
+                Err(err) => return err,
+            }
+        }
+    }
+
+    let pairs = match lazy_frame.select(pair_exprs).collect() {
+        Ok(df) => df,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+
+    let mut matrix = vec![vec![None; n]; n];
+    for i in 0..n {
+        for j in i..n {
+            let value = match pairs
+                .column(&format!("{}_{}", i, j))
+                .and_then(|c| c.as_materialized_series().f64().map(|ca| ca.get(0)))
+            {
+                Ok(value) => value,
+                Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+            };
+            matrix[i][j] = value;
+            matrix[j][i] = value;
+        }
+    }
+
+    let mut result_columns = Vec::with_capacity(n + 1);
+    result_columns.push(Column::new("column".into(), &columns));
+    for (j, name) in columns.iter().enumerate() {
+        let values: Vec<Option<f64>> = matrix.iter().map(|row| row[j]).collect();
+        result_columns.push(Column::new(name.as_str().into(), values));
+    }
+
+    match DataFrame::new(result_columns) {
+        Ok(df) => FfiResult::success(df),
+        Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    }
+}
+
+/// Dispatch function for top_k/bottom_k with key expressions taken from the expression stack
+/// Polars plans this as a partial sort; the kept rows come out ordered by key, nulls last
+pub fn dispatch_top_k(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "top_k") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const TopKArgs) };
+    let expr_stack = unsafe { &mut *context.expr_stack };
+
+    if args.key_count <= 0 {
+        return FfiResult::error(ERROR_NULL_ARGS, "TopK requires at least one expression");
+    }
+    if expr_stack.len() != args.key_count as usize {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!(
+                "TopK expects {} key expressions but found {} on the stack",
+                args.key_count,
+                expr_stack.len()
+            ),
+        );
+    }
+
+    let exprs: Vec<Expr> = expr_stack.drain(..).collect();
+    let k = args.k as IdxSize;
+    let sort_options = SortMultipleOptions::default();
+
+    if args.bottom {
+        FfiResult::success_lazy(lazy_frame.bottom_k(k, exprs, sort_options))
+    } else {
+        FfiResult::success_lazy(lazy_frame.top_k(k, exprs, sort_options))
+    }
+}
+
+/// Dispatch function for sorting by expressions taken from the expression stack
+pub fn dispatch_sort_expr(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "sort") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const SortExprArgs) };
+    let expr_stack = unsafe { &mut *context.expr_stack };
+
+    if args.key_count <= 0 {
+        return FfiResult::error(ERROR_NULL_ARGS, "Sort requires at least one expression");
+    }
+    if expr_stack.len() != args.key_count as usize {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!(
+                "Sort expects {} key expressions but found {} on the stack",
+                args.key_count,
+                expr_stack.len()
+            ),
+        );
+    }
+
+    let keys = unsafe { std::slice::from_raw_parts(args.keys, args.key_count as usize) };
+    let descending: Vec<bool> = keys
+        .iter()
+        .map(|key| matches!(key.direction, SortDirection::Descending))
+        .collect();
+    let nulls_last: Vec<bool> = keys
+        .iter()
+        .map(|key| matches!(key.nulls_ordering, NullsOrdering::Last))
+        .collect();
+
+    let exprs: Vec<Expr> = expr_stack.drain(..).collect();
+    let sort_options = SortMultipleOptions::default()
+        .with_order_descending_multi(descending)
+        .with_nulls_last_multi(nulls_last)
+        .with_maintain_order(args.maintain_order);
+
+    FfiResult::success_lazy(lazy_frame.sort_by_exprs(exprs, sort_options))
+}
+
 /// Dispatch function for limit operations
 pub fn dispatch_limit(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
     if handle.handle == 0 {
@@ -307,6 +792,142 @@ pub fn dispatch_limit(handle: PolarsHandle, context: &ExecutionContext) -> FfiRe
     }
 }
 
+/// Dispatch function for tail operations (keeps the last n rows)
+pub fn dispatch_tail(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "tail") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const LimitArgs) };
+
+    if args.n == 0 {
+        return FfiResult::error(ERROR_NULL_ARGS, "Tail must be greater than 0");
+    }
+
+    FfiResult::success_lazy(lazy_frame.tail(args.n as IdxSize))
+}
+
+/// Dispatch function for slice operations (an offset past the end yields an empty frame)
+pub fn dispatch_slice(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "slice") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const SliceArgs) };
+
+    FfiResult::success_lazy(lazy_frame.slice(args.offset, args.length as IdxSize))
+}
+
+/// Dispatch function for reversing row order
+pub fn dispatch_reverse(handle: PolarsHandle) -> FfiResult {
+    match lazy_frame_from_handle(handle, "reverse") {
+        Ok(lazy_frame) => FfiResult::success_lazy(lazy_frame.reverse()),
+        Err(err) => err,
+    }
+}
+
+/// Dispatch function for null_count: one row holding each column's number of nulls
+pub fn dispatch_null_count(handle: PolarsHandle) -> FfiResult {
+    match lazy_frame_from_handle(handle, "null_count") {
+        Ok(lazy_frame) => FfiResult::success_lazy(lazy_frame.null_count()),
+        Err(err) => err,
+    }
+}
+
+/// Dispatch function for unnest: each struct column is replaced in place by its fields
+pub fn dispatch_unnest(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "unnest") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const UnnestArgs) };
+
+    let columns: Vec<Selector> = match unsafe { raw_str_array_to_vec(args.columns, args.column_count) } {
+        Ok(cols) => cols
+            .iter()
+            .map(|name| Selector::Root(Box::new(col(name.as_str()))))
+            .collect(),
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+
+    FfiResult::success_lazy(lazy_frame.unnest(columns))
+}
+
+/// Dispatch function for value_counts: one row per distinct value with its count or proportion
+/// Sorting breaks count ties by value so the output order is deterministic
+pub fn dispatch_value_counts(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "value_counts") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const ValueCountsArgs) };
+    let column = match unsafe { args.column.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in column name"),
+    };
+    let count_name = if args.normalize { "proportion" } else { "count" };
+
+    // value_counts yields a struct column named after the input; unnest it into two columns
+    let counts = lazy_frame
+        .select([col(column).value_counts(false, true, count_name.into(), args.normalize)])
+        .unnest([Selector::Root(Box::new(col(column)))]);
+
+    if !args.sort {
+        return FfiResult::success_lazy(counts);
+    }
+    let sort_options = SortMultipleOptions::default().with_order_descending_multi([true, false]);
+    FfiResult::success_lazy(counts.sort_by_exprs([col(count_name), col(column)], sort_options))
+}
+
+/// Dispatch function for sample operations
+/// Sampling needs the row count, so the input is collected and the result is an eager DataFrame
+pub fn dispatch_sample(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "sample") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const SampleArgs) };
+
+    let df = match lazy_frame.collect() {
+        Ok(df) => df,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+
+    let seed = Some(args.seed);
+    let sampled = if args.n > 0 {
+        df.sample_n_literal(args.n, args.with_replacement, false, seed)
+    } else {
+        let fraction = Series::new("fraction".into(), [args.fraction]);
+        df.sample_frac(&fraction, args.with_replacement, false, seed)
+    };
+
+    match sampled {
+        Ok(sampled) => FfiResult::success(sampled),
+        Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    }
+}
+
+/// Dispatch function for gather_every operations (keeps rows offset, offset+n, offset+2n, ...)
+pub fn dispatch_gather_every(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "gather_every") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const GatherEveryArgs) };
+
+    if args.n == 0 {
+        return FfiResult::error(ERROR_NULL_ARGS, "GatherEvery requires n > 0");
+    }
+
+    FfiResult::success_lazy(lazy_frame.select([all().gather_every(args.n, args.offset)]))
+}
+
 /// Dispatch function for count operation (returns DataFrame with count column)
 pub fn dispatch_count(handle: PolarsHandle) -> FfiResult {
     if handle.handle == 0 {
@@ -342,7 +963,8 @@ pub fn dispatch_count(handle: PolarsHandle) -> FfiResult {
     }
 }
 
-/// Concatenate multiple DataFrames vertically (union)
+/// Concatenate multiple DataFrames vertically (union), horizontally (side by side) or
+/// diagonally (union of differing schemas)
 /// Note: _handle is unused as this follows functional style concat(df1, df2, df3)
 /// rather than method style df1.concat(df2, df3)
 pub fn dispatch_concat(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
@@ -354,18 +976,37 @@ pub fn dispatch_concat(_handle: PolarsHandle, context: &ExecutionContext) -> Ffi
 
     // Convert handle array to DataFrames
     let handles = unsafe { std::slice::from_raw_parts(args.handles, args.count) };
-    let mut dataframes = Vec::new();
-
+    let mut frames = Vec::with_capacity(handles.len());
     for &handle in handles {
         if handle == 0 {
             return FfiResult::error(ERROR_NULL_HANDLE, "DataFrame handle cannot be null");
         }
-        let df = unsafe { &*(handle as *const DataFrame) };
-        dataframes.push(df.clone().lazy());
+        frames.push(unsafe { &*(handle as *const DataFrame) });
     }
 
+    if args.how == CONCAT_HOW_HORIZONTAL {
+        return concat_horizontal(&frames);
+    }
+    let dataframes: Vec<LazyFrame> = frames.into_iter().map(|df| df.clone().lazy()).collect();
+
     // Concatenate all DataFrames
-    match concat(dataframes, UnionArgs::default()) {
+    let concatenated = match args.how {
+        CONCAT_HOW_VERTICAL => concat(dataframes, UnionArgs::default()),
+        CONCAT_HOW_DIAGONAL => concat_lf_diagonal(
+            dataframes,
+            UnionArgs {
+                to_supertypes: true, // e.g. i64 and f64 versions of a column become f64
+                ..UnionArgs::default()
+            },
+        ),
+        how => {
+            return FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!("Unknown concat strategy {}", how),
+            )
+        }
+    };
+    match concatenated {
         Ok(lazy_frame) => match lazy_frame.collect() {
             Ok(df) => FfiResult::success(df),
             Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
@@ -374,6 +1015,33 @@ pub fn dispatch_concat(_handle: PolarsHandle, context: &ExecutionContext) -> Ffi
     }
 }
 
+/// Stack the columns of equal-height DataFrames side by side
+/// Polars would pad shorter frames with nulls, so mismatched heights are rejected up front
+fn concat_horizontal(frames: &[&DataFrame]) -> FfiResult {
+    let height = frames[0].height();
+    for (i, df) in frames.iter().enumerate().skip(1) {
+        if df.height() != height {
+            return FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!(
+                    "horizontal concat requires equal heights: frame {} has {} rows, frame 0 has {}",
+                    i,
+                    df.height(),
+                    height
+                ),
+            );
+        }
+    }
+
+    let mut result = frames[0].clone();
+    for df in &frames[1..] {
+        if let Err(e) = result.hstack_mut(df.get_columns()) {
+            return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string());
+        }
+    }
+    FfiResult::success(result)
+}
+
 /// Dispatch function for select with expressions operation
 pub fn dispatch_select_expr(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
     if handle.handle == 0 {
@@ -523,13 +1191,17 @@ pub fn dispatch_filter_expr(handle: PolarsHandle, context: &ExecutionContext) ->
 
 /// Dispatch function for join operations
 pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const JoinArgs) };
+    let _retained = RetainedRight(PolarsHandle {
+        handle: args.other_handle,
+        context_type: args.other_context_type,
+    });
+
     if handle.handle == 0 {
         return FfiResult::error(ERROR_NULL_HANDLE, "Left handle cannot be null");
     }
 
-    let args = unsafe { &*(context.operation_args as *const JoinArgs) };
-
-    if args.other_handle == 0 {
+    if args.other_handle == 0 && args.other_op_count == 0 {
         return FfiResult::error(ERROR_NULL_HANDLE, "Right handle cannot be null");
     }
 
@@ -543,7 +1215,7 @@ pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
     };
 
     // For cross joins, we don't need join columns
-    let (left_on_exprs, right_on_exprs) = if matches!(join_how, polars::prelude::JoinType::Cross) {
+    let (left_columns, right_columns) = if matches!(join_how, polars::prelude::JoinType::Cross) {
         (Vec::new(), Vec::new())
     } else {
         // For non-cross joins, we need join columns
@@ -562,19 +1234,33 @@ pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
             Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
         };
 
-        // Convert column names to Polars expressions
-        let left_on_exprs: Vec<Expr> = left_columns.iter().map(|s| col(s)).collect();
-        let right_on_exprs: Vec<Expr> = right_columns.iter().map(|s| col(s)).collect();
-        
-        (left_on_exprs, right_on_exprs)
+        (left_columns, right_columns)
     };
 
+    // Convert column names to Polars expressions
+    let left_on_exprs: Vec<Expr> = left_columns.iter().map(|s| col(s)).collect();
+    let right_on_exprs: Vec<Expr> = right_columns.iter().map(|s| col(s)).collect();
+
     // Get context types for both DataFrames
     let left_context_type = match handle.get_context_type() {
         Some(ct) => ct,
         None => return FfiResult::error(ERROR_POLARS_OPERATION, "Invalid left context type"),
     };
 
+    // The right side may be collected, lazy, or still carrying its own pending operations
+    let other = PolarsHandle {
+        handle: args.other_handle,
+        context_type: args.other_context_type,
+    };
+    let right_lazy = match join_right_lazy(other, args.other_ops, args.other_op_count) {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+    let right_schema = match right_lazy.clone().collect_schema() {
+        Ok(schema) => schema,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+
     // Handle suffix for duplicate columns
     let suffix = if args.suffix.len > 0 {
         match unsafe { args.suffix.as_str() } {
@@ -589,10 +1275,17 @@ pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
         ContextType::DataFrame => {
             // Both DataFrames - convert to LazyFrames for join, then collect
             let left_df = unsafe { &*(handle.handle as *const DataFrame) };
-            let right_df = unsafe { &*(args.other_handle as *const DataFrame) };
+
+            if let Err(msg) = validate_join_keys(
+                &left_df.schema(),
+                &right_schema,
+                &left_columns,
+                &right_columns,
+            ) {
+                return FfiResult::error(ERROR_POLARS_OPERATION, &msg);
+            }
 
             let left_lazy = left_df.clone().lazy();
-            let right_lazy = right_df.clone().lazy();
 
             // Create JoinArgs for Polars - use the builder pattern
             let mut polars_join_args = PolarJoinArgs::new(join_how);
@@ -606,7 +1299,14 @@ pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
             }
 
             // Perform the join
-            let joined_lazy = left_lazy.join(right_lazy, left_on_exprs, right_on_exprs, polars_join_args);
+            let joined_lazy = join_lazy(
+                left_lazy,
+                right_lazy,
+                left_on_exprs,
+                right_on_exprs,
+                polars_join_args,
+                args.indicator,
+            );
 
             // Collect to DataFrame
             match joined_lazy.collect() {
@@ -615,9 +1315,19 @@ pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
             }
         }
         ContextType::LazyFrame => {
-            // Both LazyFrames - join directly
+            // Lazy left side - join directly
             let left_lazy = unsafe { &*(handle.handle as *const LazyFrame) };
-            let right_lazy = unsafe { &*(args.other_handle as *const LazyFrame) };
+
+            // Resolve both schemas so key dtype mismatches fail here with a clear message
+            let left_schema = match left_lazy.clone().collect_schema() {
+                Ok(schema) => schema,
+                Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+            };
+            if let Err(msg) =
+                validate_join_keys(&left_schema, &right_schema, &left_columns, &right_columns)
+            {
+                return FfiResult::error(ERROR_POLARS_OPERATION, &msg);
+            }
 
             // Create JoinArgs for Polars - use the builder pattern
             let mut polars_join_args = PolarJoinArgs::new(join_how);
@@ -631,7 +1341,14 @@ pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
             }
 
             // Perform the join
-            let joined_lazy = left_lazy.clone().join(right_lazy.clone(), left_on_exprs, right_on_exprs, polars_join_args);
+            let joined_lazy = join_lazy(
+                left_lazy.clone(),
+                right_lazy,
+                left_on_exprs,
+                right_on_exprs,
+                polars_join_args,
+                args.indicator,
+            );
 
             FfiResult::success_lazy(joined_lazy)
         }
@@ -645,6 +1362,220 @@ pub fn dispatch_join(handle: PolarsHandle, context: &ExecutionContext) -> FfiRes
     }
 }
 
+/// Resolve the right side of a join to a LazyFrame
+/// When the right frame still has pending operations they run here, starting from its handle
+/// (0 if it was never executed), so the join plans both inputs together. The intermediate
+/// handle produced by that chain is freed once its plan has been cloned.
+/// Shared by regular and as-of joins.
+fn join_right_lazy(
+    other: PolarsHandle,
+    other_ops: *const Operation,
+    other_op_count: usize,
+) -> std::result::Result<LazyFrame, FfiResult> {
+    if other_ops.is_null() || other_op_count == 0 {
+        return lazy_frame_from_handle(other, "join");
+    }
+
+    let operations = unsafe { std::slice::from_raw_parts(other_ops, other_op_count) };
+    let result = run_operations(other, operations);
+    if result.error_code != 0 {
+        let message = if result.error_message.is_null() {
+            String::from("unknown error")
+        } else {
+            unsafe { CString::from_raw(result.error_message) }
+                .to_string_lossy()
+                .into_owned()
+        };
+        return Err(FfiResult::error(
+            result.error_code,
+            &format!("join right side: {}", message),
+        ));
+    }
+
+    let right_lazy = lazy_frame_from_handle(result.polars_handle, "join");
+    if result.polars_handle.handle != other.handle {
+        release_intermediate(result.polars_handle);
+    }
+    right_lazy
+}
+
+/// The reference the Go side retained on a join's right frame when it captured it
+/// Dropped when the join dispatch returns, whether or not it succeeded; by then the right
+/// side has been cloned into the join's plan.
+struct RetainedRight(PolarsHandle);
+
+impl Drop for RetainedRight {
+    fn drop(&mut self) {
+        if self.0.handle != 0 {
+            release_intermediate(self.0);
+        }
+    }
+}
+
+/// Free a handle created while resolving an operation chain, honouring its context type
+fn release_intermediate(handle: PolarsHandle) {
+    if unregister_handle_ref(handle.handle) != Some(true) {
+        return;
+    }
+    unsafe {
+        match handle.get_context_type() {
+            Some(ContextType::DataFrame) => drop(Box::from_raw(handle.handle as *mut DataFrame)),
+            Some(ContextType::LazyFrame) => drop(Box::from_raw(handle.handle as *mut LazyFrame)),
+            Some(ContextType::LazyGroupBy) => {
+                drop(Box::from_raw(handle.handle as *mut LazyGroupBy))
+            }
+            None => {}
+        }
+    }
+}
+
+/// Dispatch function for as-of joins
+/// Each left row is matched with the nearest right row by the sorted asof key (within the
+/// optional by groups). Both keys must be sorted; Polars rejects keys it detects as unsorted.
+pub fn dispatch_join_asof(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const AsofJoinArgs) };
+    let _retained = RetainedRight(PolarsHandle {
+        handle: args.other_handle,
+        context_type: args.other_context_type,
+    });
+
+    let left_lazy = match lazy_frame_from_handle(handle, "join_asof") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    if args.other_handle == 0 && args.other_op_count == 0 {
+        return FfiResult::error(ERROR_NULL_HANDLE, "Right handle cannot be null");
+    }
+    let other = PolarsHandle {
+        handle: args.other_handle,
+        context_type: args.other_context_type,
+    };
+    let right_lazy = match join_right_lazy(other, args.other_ops, args.other_op_count) {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let (left_on, right_on, tolerance) = match unsafe {
+        (args.left_on.as_str(), args.right_on.as_str(), args.tolerance.as_str())
+    } {
+        (Ok(left_on), Ok(right_on), Ok(tolerance)) => (left_on, right_on, tolerance),
+        _ => {
+            return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in as-of join arguments")
+        }
+    };
+
+    let by = match unsafe { optional_raw_str_array_to_vec(args.by, args.by_count) } {
+        Ok(cols) if cols.is_empty() => None,
+        Ok(cols) => Some(cols.into_iter().map(PlSmallStr::from).collect::<Vec<_>>()),
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+
+    let strategy = match args.strategy {
+        ASOF_STRATEGY_BACKWARD => AsofStrategy::Backward,
+        ASOF_STRATEGY_FORWARD => AsofStrategy::Forward,
+        ASOF_STRATEGY_NEAREST => AsofStrategy::Nearest,
+        other => {
+            return FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!("Unknown as-of join strategy {}", other),
+            )
+        }
+    };
+
+    // Numeric tolerances apply to numeric keys; anything else is a duration such as "5m"
+    let (tolerance, tolerance_str) = if tolerance.is_empty() {
+        (None, None)
+    } else if let Ok(value) = tolerance.parse::<f64>() {
+        (Some(AnyValue::Float64(value)), None)
+    } else {
+        (None, Some(PlSmallStr::from(tolerance)))
+    };
+
+    let options = AsOfOptions {
+        strategy,
+        tolerance,
+        tolerance_str,
+        left_by: by.clone(),
+        right_by: by,
+        ..Default::default()
+    };
+
+    let joined = left_lazy
+        .join_builder()
+        .with(right_lazy)
+        .left_on([col(left_on)])
+        .right_on([col(right_on)])
+        .how(polars::prelude::JoinType::AsOf(options))
+        .finish();
+
+    FfiResult::success_lazy(joined)
+}
+
+/// Name of the join indicator column, matching pandas' merge(indicator=True)
+const JOIN_INDICATOR_COLUMN: &str = "_merge";
+const JOIN_LEFT_MARKER: &str = "__firn_join_left";
+const JOIN_RIGHT_MARKER: &str = "__firn_join_right";
+
+/// Join two LazyFrames, optionally adding a _merge column valued "left_only", "right_only" or "both"
+/// The indicator is derived from marker columns added to each side before the join
+fn join_lazy(
+    left: LazyFrame,
+    right: LazyFrame,
+    left_on: Vec<Expr>,
+    right_on: Vec<Expr>,
+    join_args: PolarJoinArgs,
+    indicator: bool,
+) -> LazyFrame {
+    if !indicator {
+        return left.join(right, left_on, right_on, join_args);
+    }
+
+    let left = left.with_column(lit(true).alias(JOIN_LEFT_MARKER));
+    let right = right.with_column(lit(true).alias(JOIN_RIGHT_MARKER));
+    let from_left = col(JOIN_LEFT_MARKER).is_not_null();
+    let from_right = col(JOIN_RIGHT_MARKER).is_not_null();
+
+    left.join(right, left_on, right_on, join_args)
+        .with_column(
+            when(from_left.clone().and(from_right))
+                .then(lit("both"))
+                .when(from_left)
+                .then(lit("left_only"))
+                .otherwise(lit("right_only"))
+                .alias(JOIN_INDICATOR_COLUMN),
+        )
+        .drop([JOIN_LEFT_MARKER, JOIN_RIGHT_MARKER])
+}
+
+/// Check that every join key exists on its side and that paired keys have compatible dtypes
+/// Numeric keys of different widths are accepted; anything else must match exactly
+fn validate_join_keys(
+    left_schema: &Schema,
+    right_schema: &Schema,
+    left_columns: &[String],
+    right_columns: &[String],
+) -> Result<(), String> {
+    for (left_name, right_name) in left_columns.iter().zip(right_columns) {
+        let left_dtype = left_schema
+            .get(left_name.as_str())
+            .ok_or_else(|| format!("Join key '{}' not found in left DataFrame", left_name))?;
+        let right_dtype = right_schema
+            .get(right_name.as_str())
+            .ok_or_else(|| format!("Join key '{}' not found in right DataFrame", right_name))?;
+
+        let compatible = left_dtype == right_dtype
+            || (left_dtype.is_numeric() && right_dtype.is_numeric());
+        if !compatible {
+            return Err(format!(
+                "Join key dtype mismatch: left '{}' is {} but right '{}' is {}",
+                left_name, left_dtype, right_name, right_dtype
+            ));
+        }
+    }
+    Ok(())
+}
+
 /// Convert DataFrame to CSV string
 #[no_mangle]
 pub extern "C" fn dataframe_to_csv(handle: usize) -> *mut c_char {
@@ -668,6 +1599,52 @@ pub extern "C" fn dataframe_to_csv(handle: usize) -> *mut c_char {
     }
 }
 
+/// Serialize DataFrame to CSV bytes without NUL-terminated string conversion
+/// On success writes a Rust-owned buffer to out_data/out_len (release with free_bytes) and returns 0
+#[no_mangle]
+pub extern "C" fn dataframe_to_csv_bytes(
+    handle: usize,
+    include_header: bool,
+    separator: u8,
+    out_data: *mut *mut u8,
+    out_len: *mut usize,
+) -> c_int {
+    if handle == 0 || out_data.is_null() || out_len.is_null() {
+        return 1;
+    }
+
+    let df = unsafe { &*(handle as *const DataFrame) };
+    let separator = if separator != 0 { separator } else { b',' };
+
+    let mut buffer = Vec::new();
+    let mut df_clone = df.clone();
+    if CsvWriter::new(&mut buffer)
+        .include_header(include_header)
+        .with_separator(separator)
+        .finish(&mut df_clone)
+        .is_err()
+    {
+        return 1;
+    }
+
+    let bytes = buffer.into_boxed_slice();
+    unsafe {
+        *out_len = bytes.len();
+        *out_data = Box::into_raw(bytes) as *mut u8;
+    }
+    0
+}
+
+/// Free a buffer returned by dataframe_to_csv_bytes
+#[no_mangle]
+pub extern "C" fn free_bytes(data: *mut u8, len: usize) {
+    if !data.is_null() {
+        unsafe {
+            let _ = Box::from_raw(std::ptr::slice_from_raw_parts_mut(data, len));
+        }
+    }
+}
+
 /// Convert DataFrame to string representation (tabular format)
 #[no_mangle]
 pub extern "C" fn dataframe_to_string(handle: usize) -> *mut c_char {
@@ -695,15 +1672,59 @@ pub extern "C" fn dataframe_height(handle: usize) -> usize {
     df.height()
 }
 
-/// Release DataFrame memory
+/// Get DataFrame width (number of columns)
+#[no_mangle]
+pub extern "C" fn dataframe_width(handle: usize) -> usize {
+    if handle == 0 {
+        return 0;
+    }
+
+    let df = unsafe { &*(handle as *const DataFrame) };
+    df.width()
+}
+
+/// Borrow the name of the column at index (empty when out of range)
+/// The returned RawStr points into the DataFrame and is valid until the handle is released.
+#[no_mangle]
+pub extern "C" fn dataframe_column_name(handle: usize, index: usize) -> RawStr {
+    let empty = RawStr {
+        data: ptr::null(),
+        len: 0,
+    };
+    if handle == 0 {
+        return empty;
+    }
+
+    let df = unsafe { &*(handle as *const DataFrame) };
+    match df.get_columns().get(index) {
+        Some(column) => {
+            let name = column.name().as_str();
+            RawStr {
+                data: name.as_ptr() as *const c_char,
+                len: name.len(),
+            }
+        }
+        None => empty,
+    }
+}
+
+/// Release one owner of a DataFrame handle, freeing the memory when the last owner releases
+/// Returns 1 for a handle that is unknown or already freed
 #[no_mangle]
 pub extern "C" fn release_dataframe(handle: usize) -> c_int {
-    if handle != 0 {
-        unsafe {
-            let _ = Box::from_raw(handle as *mut DataFrame);
+    if handle == 0 {
+        return 0;
+    }
+    match unregister_handle_ref(handle) {
+        Some(true) => {
+            unsafe {
+                let _ = Box::from_raw(handle as *mut DataFrame);
+            }
+            0
         }
+        Some(false) => 0, // Other owners still hold the handle
+        None => 1,
     }
-    0 // Return success
 }
 
 /// Free C string memory
@@ -725,7 +1746,7 @@ pub extern "C" fn noop() -> c_int {
 /// Testing helper - adds a null row to DataFrame
 /// Collect operation - materializes LazyFrames into DataFrames
 /// If already a DataFrame, returns it as-is
-pub fn dispatch_collect(handle: PolarsHandle) -> FfiResult {
+pub fn dispatch_collect(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
     if handle.handle == 0 {
         return FfiResult::error(ERROR_NULL_HANDLE, "Handle cannot be null");
     }
@@ -745,7 +1766,22 @@ pub fn dispatch_collect(handle: PolarsHandle) -> FfiResult {
         ContextType::LazyFrame => {
             // Materialize LazyFrame into DataFrame
             let lazy_frame = unsafe { &*(handle.handle as *const LazyFrame) };
-            match lazy_frame.clone().collect() {
+            let (streaming, cancel_token) = if context.operation_args != 0 {
+                let args = unsafe { &*(context.operation_args as *const CollectArgs) };
+                (args.streaming, args.cancel_token)
+            } else {
+                (false, 0)
+            };
+            let lazy_frame = lazy_frame.clone().with_streaming(streaming);
+            let result = if cancel_token != 0 {
+                match collect_cancellable(lazy_frame, cancel_token) {
+                    Some(result) => result,
+                    None => return FfiResult::error(ERROR_CANCELLED, "query cancelled"),
+                }
+            } else {
+                lazy_frame.collect()
+            };
+            match result {
                 Ok(df) => FfiResult::success(df),
                 Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
             }
@@ -763,6 +1799,42 @@ pub fn dispatch_collect(handle: PolarsHandle) -> FfiResult {
     }
 }
 
+/// Fetch operation - runs the plan against only the first n_rows of each source
+/// Materialized DataFrames are simply truncated to n_rows
+pub fn dispatch_fetch(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    if handle.handle == 0 {
+        return FfiResult::error(ERROR_NULL_HANDLE, "Handle cannot be null");
+    }
+
+    let context_type = match handle.get_context_type() {
+        Some(ct) => ct,
+        None => return FfiResult::error(ERROR_POLARS_OPERATION, "Invalid context type"),
+    };
+
+    let args = unsafe { &*(context.operation_args as *const FetchArgs) };
+
+    match context_type {
+        ContextType::DataFrame => {
+            let df = unsafe { &*(handle.handle as *const DataFrame) };
+            FfiResult::success(df.head(Some(args.n_rows)))
+        }
+        ContextType::LazyFrame => {
+            let lazy_frame = unsafe { &*(handle.handle as *const LazyFrame) };
+            match lazy_frame.clone().fetch(args.n_rows) {
+                Ok(df) => FfiResult::success(df),
+                Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+            }
+        }
+        ContextType::LazyGroupBy => FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!(
+                "Cannot fetch {}. Call agg() first to resolve grouping.",
+                context_type.name()
+            ),
+        ),
+    }
+}
+
 pub fn dispatch_add_null_row(handle: PolarsHandle) -> FfiResult {
     if handle.handle == 0 {
         return FfiResult::error(ERROR_NULL_HANDLE, "Handle cannot be null");
@@ -819,6 +1891,10 @@ pub fn dispatch_add_null_row(handle: PolarsHandle) -> FfiResult {
 /// Execute SQL query on a DataFrame
 /// Registers the DataFrame as "df" table and executes the SQL query
 pub fn dispatch_query(handle: PolarsHandle, ctx: &ExecutionContext) -> FfiResult {
+    if handle.handle == 0 {
+        return FfiResult::error(ERROR_NULL_HANDLE, "Handle cannot be null");
+    }
+
     if ctx.operation_args == 0 {
         return FfiResult::error(ERROR_NULL_ARGS, "QueryArgs cannot be null");
     }
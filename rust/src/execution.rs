@@ -82,6 +82,12 @@ fn dispatch_expression_operation(opcode: OpCode, ctx: &ExecutionContext) -> FfiR
         OpCode::ExprStrToLowercase => expr_str_to_lowercase(ctx),
         OpCode::ExprStrToUppercase => expr_str_to_uppercase(ctx),
         OpCode::ExprSql => expr_sql(ctx),
+        OpCode::ExprDot => expr_dot(ctx),
+        OpCode::ExprHash => expr_hash(ctx),
+        OpCode::ExprQuantile => expr_quantile(ctx),
+        OpCode::ExprQuantiles => expr_quantiles(ctx),
+        OpCode::ExprWeightedMean => expr_weighted_mean(ctx),
+        OpCode::ExprClipQuantile => expr_clip_quantile(ctx),
         // Window function operations
         OpCode::ExprOver => expr_over(ctx),
         OpCode::ExprRank => expr_rank(ctx),
@@ -95,6 +101,82 @@ fn dispatch_expression_operation(opcode: OpCode, ctx: &ExecutionContext) -> FfiR
         OpCode::ExprOtherwise => expr_otherwise(ctx),
         // Cast operations
         OpCode::ExprCast => expr_cast(ctx),
+        // Additional comparison operations
+        OpCode::ExprGe => expr_ge(ctx),
+        OpCode::ExprLe => expr_le(ctx),
+        OpCode::ExprNe => expr_ne(ctx),
+        OpCode::ExprIsIn => expr_is_in(ctx),
+        // Null handling operations
+        OpCode::ExprFillNull => expr_fill_null(ctx),
+        OpCode::ExprForwardFill => expr_forward_fill(ctx),
+        OpCode::ExprBackwardFill => expr_backward_fill(ctx),
+        OpCode::ExprInterpolate => expr_interpolate(ctx),
+        OpCode::ExprCoalesce => expr_coalesce(ctx),
+        // Numeric operations
+        OpCode::ExprAbs => expr_abs(ctx),
+        OpCode::ExprRound => expr_round(ctx),
+        OpCode::ExprFloor => expr_floor(ctx),
+        OpCode::ExprCeil => expr_ceil(ctx),
+        OpCode::ExprPow => expr_pow(ctx),
+        OpCode::ExprSqrt => expr_sqrt(ctx),
+        OpCode::ExprExp => expr_exp(ctx),
+        OpCode::ExprLog => expr_log(ctx),
+        OpCode::ExprMod => expr_mod(ctx),
+        OpCode::ExprClip => expr_clip(ctx),
+        // Additional string operations
+        OpCode::ExprStrStripChars => expr_str_strip_chars(ctx),
+        OpCode::ExprStrStripPrefix => expr_str_strip_prefix(ctx),
+        OpCode::ExprStrStripSuffix => expr_str_strip_suffix(ctx),
+        OpCode::ExprStrExtract => expr_str_extract(ctx),
+        OpCode::ExprConcatStr => expr_concat_str(ctx),
+        OpCode::ExprStrJoin => expr_str_join(ctx),
+        // Temporal operations
+        OpCode::ExprDtYear => expr_dt_year(ctx),
+        OpCode::ExprDtMonth => expr_dt_month(ctx),
+        OpCode::ExprDtDay => expr_dt_day(ctx),
+        OpCode::ExprDtHour => expr_dt_hour(ctx),
+        OpCode::ExprDtMinute => expr_dt_minute(ctx),
+        OpCode::ExprDtSecond => expr_dt_second(ctx),
+        OpCode::ExprDtWeekday => expr_dt_weekday(ctx),
+        OpCode::ExprStrptime => expr_strptime(ctx),
+        OpCode::ExprDtTruncate => expr_dt_truncate(ctx),
+        // Cumulative operations
+        OpCode::ExprCumsum => expr_cumsum(ctx),
+        OpCode::ExprCummax => expr_cummax(ctx),
+        OpCode::ExprCummin => expr_cummin(ctx),
+        OpCode::ExprCumprod => expr_cumprod(ctx),
+        // Row offset operations
+        OpCode::ExprShift => expr_shift(ctx),
+        OpCode::ExprPctChange => expr_pct_change(ctx),
+        // Positional aggregations
+        OpCode::ExprArgMax => expr_arg_max(ctx),
+        OpCode::ExprArgMin => expr_arg_min(ctx),
+        // Distribution shape aggregations
+        OpCode::ExprSkew => expr_skew(ctx),
+        OpCode::ExprKurtosis => expr_kurtosis(ctx),
+        // Multiplicative aggregations
+        OpCode::ExprProduct => expr_product(ctx),
+        // Float predicates
+        OpCode::ExprIsNaN => expr_is_nan(ctx),
+        OpCode::ExprIsNotNaN => expr_is_not_nan(ctx),
+        OpCode::ExprIsFinite => expr_is_finite(ctx),
+        OpCode::ExprIsInfinite => expr_is_infinite(ctx),
+        // Uniqueness predicates
+        OpCode::ExprIsUnique => expr_is_unique(ctx),
+        OpCode::ExprIsDuplicated => expr_is_duplicated(ctx),
+        OpCode::ExprExclude => expr_exclude(ctx),
+        OpCode::ExprNamePrefix => expr_name_prefix(ctx),
+        OpCode::ExprNameSuffix => expr_name_suffix(ctx),
+        OpCode::ExprNameKeep => expr_name_keep(ctx),
+        OpCode::ExprLen => expr_len(ctx),
+        OpCode::ExprNullCount => expr_null_count(ctx),
+        OpCode::ExprListLen => expr_list_len(ctx),
+        OpCode::ExprListContains => expr_list_contains(ctx),
+        OpCode::ExprListGet => expr_list_get(ctx),
+        OpCode::ExprListFirst => expr_list_first(ctx),
+        OpCode::ExprListLast => expr_list_last(ctx),
+        OpCode::ExprStructField => expr_struct_field(ctx),
+        OpCode::ExprDtypeCols => expr_dtype_cols(ctx),
         _ => FfiResult::error(ERROR_POLARS_OPERATION, "Unsupported expression operation"),
     }
 }
@@ -107,11 +189,27 @@ fn dispatch_dataframe_operation(
 ) -> (FfiResult, ContextType) {
     use crate::dataframe::*;
     use crate::io::*;
+    use crate::reshape::*;
 
     match opcode {
         OpCode::NewEmpty => (dispatch_new_empty(), ContextType::DataFrame),
         OpCode::ReadCsv => (dispatch_read_csv(handle, context), ContextType::LazyFrame),
         OpCode::ReadParquet => (dispatch_read_parquet(handle, context), ContextType::LazyFrame),
+        OpCode::ReadDataset => (dispatch_read_dataset(handle, context), ContextType::LazyFrame),
+        OpCode::ReadJson => (dispatch_read_json(handle, context), ContextType::LazyFrame),
+        OpCode::ReadNdjson => (
+            dispatch_read_ndjson(handle, context),
+            ContextType::LazyFrame,
+        ),
+        OpCode::ReadIpc => (dispatch_read_ipc(handle, context), ContextType::LazyFrame),
+        OpCode::FromColumns => (
+            dispatch_from_columns(handle, context),
+            ContextType::LazyFrame,
+        ),
+        OpCode::ReadCsvBytes => (
+            dispatch_read_csv_bytes(handle, context),
+            ContextType::LazyFrame,
+        ),
         OpCode::Select => (dispatch_select(handle, context), ContextType::LazyFrame),
         OpCode::SelectExpr => (
             dispatch_select_expr(handle, context),
@@ -140,13 +238,35 @@ fn dispatch_dataframe_operation(
             (dispatch_limit(handle, context), input_context)
         }
         OpCode::AddNullRow => (dispatch_add_null_row(handle), ContextType::DataFrame),
-        OpCode::Collect => (dispatch_collect(handle), ContextType::DataFrame),
+        OpCode::Collect => (dispatch_collect(handle, context), ContextType::DataFrame),
+        OpCode::Fetch => (dispatch_fetch(handle, context), ContextType::DataFrame),
+        OpCode::Unique => (dispatch_unique(handle, context), ContextType::LazyFrame),
+        OpCode::DropNulls => (dispatch_drop_nulls(handle, context), ContextType::LazyFrame),
+        OpCode::Tail => (dispatch_tail(handle, context), ContextType::LazyFrame),
+        OpCode::Drop => (dispatch_drop(handle, context), ContextType::LazyFrame),
+        OpCode::Rename => (dispatch_rename(handle, context), ContextType::LazyFrame),
+        OpCode::CorrMatrix => (dispatch_corr_matrix(handle, context), ContextType::DataFrame),
+        OpCode::SortExpr => (dispatch_sort_expr(handle, context), ContextType::LazyFrame),
+        OpCode::GatherEvery => (
+            dispatch_gather_every(handle, context),
+            ContextType::LazyFrame,
+        ),
         OpCode::Query => (dispatch_query(handle, context), ContextType::LazyFrame),
         OpCode::Join => {
             // Join preserves the input context type (DataFrame->DataFrame, LazyFrame->LazyFrame)
             let input_context = handle.get_context_type().unwrap_or(ContextType::DataFrame);
             (dispatch_join(handle, context), input_context)
         }
+        OpCode::JoinAsof => (dispatch_join_asof(handle, context), ContextType::LazyFrame),
+        OpCode::Unpivot => (dispatch_unpivot(handle, context), ContextType::LazyFrame),
+        OpCode::Pivot => (dispatch_pivot(handle, context), ContextType::DataFrame),
+        OpCode::Sample => (dispatch_sample(handle, context), ContextType::DataFrame),
+        OpCode::Slice => (dispatch_slice(handle, context), ContextType::LazyFrame),
+        OpCode::Reverse => (dispatch_reverse(handle), ContextType::LazyFrame),
+        OpCode::NullCount => (dispatch_null_count(handle), ContextType::LazyFrame),
+        OpCode::Unnest => (dispatch_unnest(handle, context), ContextType::LazyFrame),
+        OpCode::ValueCounts => (dispatch_value_counts(handle, context), ContextType::LazyFrame),
+        OpCode::TopK => (dispatch_top_k(handle, context), ContextType::LazyFrame),
         _ => (
             FfiResult::error(ERROR_POLARS_OPERATION, "Unsupported DataFrame operation"),
             handle.get_context_type().unwrap_or(ContextType::DataFrame),
@@ -166,6 +286,12 @@ pub extern "C" fn execute_operations(
     }
 
     let operations = unsafe { std::slice::from_raw_parts(operations_ptr, count) };
+    run_operations(polars_handle, operations)
+}
+
+/// Run a chain of operations starting from a handle (0 for a chain that starts with a read)
+/// Shared by execute_operations and by joins, which run the right frame's pending chain.
+pub(crate) fn run_operations(polars_handle: PolarsHandle, operations: &[Operation]) -> FfiResult {
     let mut current_handle = polars_handle.handle;
     let mut current_context_type = polars_handle
         .get_context_type()
@@ -1,5 +1,6 @@
 use crate::{ExecutionContext, FfiResult, ERROR_INVALID_UTF8, ERROR_POLARS_OPERATION};
-use crate::types::{decode_data_type, CastArgs, ColumnArgs, LiteralArgs, AliasArgs, StringArgs, AggregationArgs, CountArgs};
+use crate::dataframe::raw_str_array_to_vec;
+use crate::types::{decode_data_type, decode_data_type_array, CastArgs, ClipArgs, CoalesceArgs, ColumnArgs, ConcatStrArgs, CumulativeArgs, DtypeColsArgs, ExcludeArgs, ExtractArgs, FillArgs, HashArgs, IsInArgs, LogArgs, MomentArgs, QuantileArgs, RoundArgs, ShiftArgs, StrptimeArgs, LiteralArgs, AliasArgs, StringArgs, AggregationArgs, CountArgs};
 use polars::prelude::*;
 
 /// Helper function for binary expression operations
@@ -53,6 +54,14 @@ pub fn expr_cast(ctx: &ExecutionContext) -> FfiResult {
     FfiResult::success_no_handle()
 }
 
+/// Hash operation - hashes each value of the top expression on the stack to a u64
+/// The seed is used for all four hasher keys, matching Python's hash(seed) defaults
+pub fn expr_hash(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const HashArgs) };
+    let seed = args.seed;
+    unary_expr_op(ctx, "hash", |expr| expr.hash(seed, seed, seed, seed))
+}
+
 
 fn unary_expr_op<F>(ctx: &ExecutionContext, op_name: &str, op: F) -> FfiResult
 where
@@ -88,6 +97,25 @@ pub fn expr_column(ctx: &ExecutionContext) -> FfiResult {
     FfiResult::success_no_handle()
 }
 
+/// Push the row count of the frame or group, counting nulls like SQL COUNT(*)
+pub fn expr_dtype_cols(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const DtypeColsArgs) };
+    let dtypes = match unsafe { decode_data_type_array(args.dtypes, args.count) } {
+        Ok(dtypes) => dtypes,
+        Err(err) => return err,
+    };
+
+    expr_stack.push(dtype_cols(dtypes));
+    FfiResult::success_no_handle()
+}
+
+pub fn expr_len(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    expr_stack.push(len());
+    FfiResult::success_no_handle()
+}
+
 pub fn expr_literal(ctx: &ExecutionContext) -> FfiResult {
     let expr_stack = unsafe { &mut *ctx.expr_stack };
     let args = unsafe { &*(ctx.operation_args as *const LiteralArgs) };
@@ -114,6 +142,196 @@ pub fn expr_eq(ctx: &ExecutionContext) -> FfiResult {
     binary_expr_op(ctx, "equality", |left, right| left.eq(right))
 }
 
+pub fn expr_ge(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "greater than or equal", |left, right| left.gt_eq(right))
+}
+
+pub fn expr_le(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "less than or equal", |left, right| left.lt_eq(right))
+}
+
+pub fn expr_ne(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "not equal", |left, right| left.neq(right))
+}
+
+/// Null replacement - fills nulls in the left expression from the right one
+/// Both sides are cast to their supertype, so a nulls-only column takes the fill value's type
+pub fn expr_fill_null(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "fill_null", |expr, value| expr.fill_null(value))
+}
+
+/// Forward fill - replaces nulls with the last non-null value (per partition under Over)
+pub fn expr_forward_fill(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const FillArgs) };
+    let limit = args.fill_limit();
+    unary_expr_op(ctx, "forward_fill", |expr| {
+        expr.fill_null_with_strategy(FillNullStrategy::Forward(limit))
+    })
+}
+
+/// Backward fill - replaces nulls with the next non-null value (per partition under Over)
+pub fn expr_backward_fill(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const FillArgs) };
+    let limit = args.fill_limit();
+    unary_expr_op(ctx, "backward_fill", |expr| {
+        expr.fill_null_with_strategy(FillNullStrategy::Backward(limit))
+    })
+}
+
+/// Linear interpolation - fills nulls between two non-null values (per partition under Over)
+/// Leading and trailing nulls have nothing to interpolate from and stay null
+pub fn expr_interpolate(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "interpolate", |expr| expr.interpolate(InterpolationMethod::Linear))
+}
+
+/// Coalesce - the first non-null value per row across the top `count` expressions
+/// The expressions were pushed in priority order and are cast to their supertype
+pub fn expr_coalesce(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const CoalesceArgs) };
+
+    if args.count == 0 || expr_stack.len() < args.count {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!("coalesce requires {} expressions on stack", args.count.max(1)),
+        );
+    }
+
+    let exprs = expr_stack.split_off(expr_stack.len() - args.count);
+    expr_stack.push(coalesce(&exprs));
+    FfiResult::success_no_handle()
+}
+
+// Numeric operations
+pub fn expr_abs(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "abs", |expr| expr.abs())
+}
+
+/// Round to a number of decimals; integer columns are returned unchanged
+pub fn expr_round(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const RoundArgs) };
+    let decimals = args.decimals;
+    unary_expr_op(ctx, "round", |expr| expr.round(decimals))
+}
+
+pub fn expr_floor(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "floor", |expr| expr.floor())
+}
+
+pub fn expr_ceil(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "ceil", |expr| expr.ceil())
+}
+
+pub fn expr_pow(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "pow", |base, exponent| base.pow(exponent))
+}
+
+pub fn expr_sqrt(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "sqrt", |expr| expr.sqrt())
+}
+
+pub fn expr_exp(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "exp", |expr| expr.exp())
+}
+
+/// Clip - bounds the expression by optional lower/upper expressions
+/// Stack layout (top last): expr, [lower], [upper]; bounds are cast to the input dtype
+pub fn expr_clip(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const ClipArgs) };
+
+    let required = 1 + args.has_lower as usize + args.has_upper as usize;
+    if expr_stack.len() < required {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!("clip requires {} expressions on stack", required),
+        );
+    }
+
+    let upper = if args.has_upper { expr_stack.pop() } else { None };
+    let lower = if args.has_lower { expr_stack.pop() } else { None };
+    let expr = expr_stack.pop().unwrap();
+
+    let clipped = match (lower, upper) {
+        (Some(lower), Some(upper)) => expr.clip(lower, upper),
+        (Some(lower), None) => expr.clip_min(lower),
+        (None, Some(upper)) => expr.clip_max(upper),
+        (None, None) => {
+            return FfiResult::error(ERROR_POLARS_OPERATION, "clip requires at least one bound")
+        }
+    };
+    expr_stack.push(clipped);
+    FfiResult::success_no_handle()
+}
+
+pub fn expr_log(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const LogArgs) };
+    let base = args.base;
+    unary_expr_op(ctx, "log", |expr| expr.log(base))
+}
+
+// Cumulative operations - row-preserving, so they compose with over() for per-partition totals
+pub fn expr_cumsum(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const CumulativeArgs) };
+    let reverse = args.reverse;
+    unary_expr_op(ctx, "cum_sum", |expr| expr.cum_sum(reverse))
+}
+
+pub fn expr_cummax(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const CumulativeArgs) };
+    let reverse = args.reverse;
+    unary_expr_op(ctx, "cum_max", |expr| expr.cum_max(reverse))
+}
+
+pub fn expr_cummin(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const CumulativeArgs) };
+    let reverse = args.reverse;
+    unary_expr_op(ctx, "cum_min", |expr| expr.cum_min(reverse))
+}
+
+pub fn expr_cumprod(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const CumulativeArgs) };
+    let reverse = args.reverse;
+    unary_expr_op(ctx, "cum_prod", |expr| expr.cum_prod(reverse))
+}
+
+/// Shift - moves values down by n rows (up for negative n), filling the boundary with nulls
+pub fn expr_shift(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const ShiftArgs) };
+    let n = args.n;
+    unary_expr_op(ctx, "shift", |expr| expr.shift(lit(n)))
+}
+
+/// Percentage change - (x[i] - x[i-n]) / x[i-n] as a float, null for the first n rows
+pub fn expr_pct_change(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const ShiftArgs) };
+    let n = args.n;
+    unary_expr_op(ctx, "pct_change", |expr| expr.pct_change(lit(n)))
+}
+
+/// Set membership - tests the top expression against a literal set
+/// The set is passed to Polars as a single Series so it is hashed once
+pub fn expr_is_in(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const IsInArgs) };
+    let set = match args.to_series() {
+        Ok(set) => set,
+        Err(err) => return err,
+    };
+
+    unary_expr_op(ctx, "is_in", |expr| expr.is_in(lit(set)))
+}
+
+/// Exclude named columns from the selector on the stack (e.g. all() or a regex col)
+pub fn expr_exclude(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const ExcludeArgs) };
+    let columns = match unsafe { raw_str_array_to_vec(args.columns, args.count) } {
+        Ok(columns) => columns,
+        Err(msg) => return FfiResult::error(ERROR_INVALID_UTF8, msg),
+    };
+
+    unary_expr_op(ctx, "exclude", |expr| expr.exclude(columns))
+}
+
 // Arithmetic operations
 pub fn expr_add(ctx: &ExecutionContext) -> FfiResult {
     binary_expr_op(ctx, "addition", |left, right| left + right)
@@ -131,6 +349,31 @@ pub fn expr_div(ctx: &ExecutionContext) -> FfiResult {
     binary_expr_op(ctx, "division", |left, right| left / right)
 }
 
+pub fn expr_mod(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "modulo", |left, right| left % right)
+}
+
+/// Dot product - multiplies the top two expressions elementwise and sums the result
+pub fn expr_dot(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "dot product", |left, right| left.dot(right))
+}
+
+/// Weighted mean: sum(value * weight) / sum(weight), null when the total weight is zero
+/// Rows where either the value or the weight is null are left out of both sums
+pub fn expr_weighted_mean(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "weighted mean", |value, weight| {
+        let value = value.cast(DataType::Float64);
+        let weight = when(value.clone().is_not_null())
+            .then(weight.cast(DataType::Float64))
+            .otherwise(lit(NULL).cast(DataType::Float64));
+        let total_weight = weight.clone().sum();
+
+        when(total_weight.clone().eq(lit(0.0)))
+            .then(lit(NULL).cast(DataType::Float64))
+            .otherwise((value * weight).sum() / total_weight)
+    })
+}
+
 // Boolean operations
 pub fn expr_and(ctx: &ExecutionContext) -> FfiResult {
     binary_expr_op(ctx, "logical AND", |left, right| left.and(right))
@@ -144,6 +387,93 @@ pub fn expr_not(ctx: &ExecutionContext) -> FfiResult {
     unary_expr_op(ctx, "logical NOT", |expr| expr.not())
 }
 
+/// Quantile aggregation - applies a single quantile to the top expression on the stack
+pub fn expr_quantile(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const QuantileArgs) };
+    let interp = match args.interpolation() {
+        Ok(interp) => interp,
+        Err(err) => return err,
+    };
+    let q = match args.values() {
+        Ok(values) => values[0],
+        Err(err) => return err,
+    };
+
+    unary_expr_op(ctx, "quantile", |expr| expr.quantile(lit(q), interp))
+}
+
+/// Quantile clipping - clamps the top expression to its own lower/upper quantiles
+/// Both bounds are computed over the same input (per group inside Agg), i.e. winsorization
+pub fn expr_clip_quantile(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const QuantileArgs) };
+    let interp = match args.interpolation() {
+        Ok(interp) => interp,
+        Err(err) => return err,
+    };
+    let (lower, upper) = match args.values() {
+        Ok([lower, upper]) => (*lower, *upper),
+        Ok(_) => {
+            return FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                "clip_quantile requires exactly 2 quantiles",
+            )
+        }
+        Err(err) => return err,
+    };
+
+    unary_expr_op(ctx, "clip_quantile", |expr| {
+        let expr = expr.cast(DataType::Float64);
+        expr.clone().clip(
+            expr.clone().quantile(lit(lower), interp),
+            expr.quantile(lit(upper), interp),
+        )
+    })
+}
+
+/// Multi-quantile aggregation - replaces the top expression with a single struct expression
+/// named "{name}_quantiles" whose fields are "{name}_p{percent}" (e.g. salary_p90)
+/// Each field is an independent quantile expression; nothing is shared between them.
+pub fn expr_quantiles(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const QuantileArgs) };
+
+    if expr_stack.is_empty() {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            "quantiles requires 1 expression on stack",
+        );
+    }
+
+    let interp = match args.interpolation() {
+        Ok(interp) => interp,
+        Err(err) => return err,
+    };
+    let values = match args.values() {
+        Ok(values) => values,
+        Err(err) => return err,
+    };
+
+    let expr = expr_stack.pop().unwrap();
+    let name = match expr.clone().meta().output_name() {
+        Ok(name) => name.to_string(),
+        Err(_) => "quantile".to_string(),
+    };
+
+    let fields: Vec<Expr> = values
+        .iter()
+        .map(|&q| {
+            // Round away float noise so 0.9 becomes "90" rather than "90.00000000000001"
+            let percent = format!("{}", (q * 100.0 * 1e6).round() / 1e6).replace('.', "_");
+            expr.clone()
+                .quantile(lit(q), interp)
+                .alias(format!("{}_p{}", name, percent))
+        })
+        .collect();
+    expr_stack.push(as_struct(fields).alias(format!("{}_quantiles", name)));
+
+    FfiResult::success_no_handle()
+}
+
 /// Sum aggregation - applies sum to the top expression on the stack
 pub fn expr_sum(ctx: &ExecutionContext) -> FfiResult {
     unary_expr_op(ctx, "sum", |expr| expr.sum())
@@ -215,11 +545,62 @@ pub fn expr_alias(ctx: &ExecutionContext) -> FfiResult {
     FfiResult::success_no_handle()
 }
 
+// Output name templating - rename every column an expression produces
+pub fn expr_name_prefix(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const AliasArgs) };
+    let prefix = match unsafe { args.name.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in name prefix"),
+    };
+    unary_expr_op(ctx, "name_prefix", |expr| expr.name().prefix(prefix))
+}
+
+pub fn expr_name_suffix(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const AliasArgs) };
+    let suffix = match unsafe { args.name.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in name suffix"),
+    };
+    unary_expr_op(ctx, "name_suffix", |expr| expr.name().suffix(suffix))
+}
+
+pub fn expr_name_keep(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "name_keep", |expr| expr.name().keep())
+}
+
 // Additional aggregation operations
 pub fn expr_median(ctx: &ExecutionContext) -> FfiResult {
     unary_expr_op(ctx, "median", |expr| expr.median())
 }
 
+pub fn expr_arg_max(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "arg_max", |expr| expr.arg_max())
+}
+
+pub fn expr_arg_min(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "arg_min", |expr| expr.arg_min())
+}
+
+/// Skew aggregation - strictly casts to Float64 first so non-numeric input fails at collect
+pub fn expr_skew(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const MomentArgs) };
+    let bias = args.bias;
+    unary_expr_op(ctx, "skew", |expr| expr.strict_cast(DataType::Float64).skew(bias))
+}
+
+/// Kurtosis aggregation - strictly casts to Float64 first so non-numeric input fails at collect
+pub fn expr_kurtosis(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const MomentArgs) };
+    let (fisher, bias) = (args.fisher, args.bias);
+    unary_expr_op(ctx, "kurtosis", |expr| {
+        expr.strict_cast(DataType::Float64).kurtosis(fisher, bias)
+    })
+}
+
+pub fn expr_product(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "product", |expr| expr.product())
+}
+
 pub fn expr_first(ctx: &ExecutionContext) -> FfiResult {
     unary_expr_op(ctx, "first", |expr| expr.first())
 }
@@ -262,6 +643,34 @@ pub fn expr_is_not_null(ctx: &ExecutionContext) -> FfiResult {
     unary_expr_op(ctx, "is_not_null", |expr| expr.is_not_null())
 }
 
+pub fn expr_is_nan(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "is_nan", |expr| expr.is_nan())
+}
+
+pub fn expr_is_not_nan(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "is_not_nan", |expr| expr.is_not_nan())
+}
+
+pub fn expr_is_finite(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "is_finite", |expr| expr.is_finite())
+}
+
+pub fn expr_is_infinite(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "is_infinite", |expr| expr.is_infinite())
+}
+
+pub fn expr_is_unique(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "is_unique", |expr| expr.is_unique())
+}
+
+pub fn expr_is_duplicated(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "is_duplicated", |expr| expr.is_duplicated())
+}
+
+pub fn expr_null_count(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "null_count", |expr| expr.null_count())
+}
+
 // String operations
 pub fn expr_str_len(ctx: &ExecutionContext) -> FfiResult {
     unary_expr_op(ctx, "str_len", |expr| expr.str().len_chars())
@@ -338,6 +747,236 @@ pub fn expr_str_ends_with(ctx: &ExecutionContext) -> FfiResult {
     FfiResult::success_no_handle()
 }
 
+/// Strip characters from both ends - an empty character set strips whitespace
+pub fn expr_str_strip_chars(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const StringArgs) };
+
+    if expr_stack.is_empty() {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            "str_strip_chars requires 1 expression on stack",
+        );
+    }
+
+    let chars_str = match unsafe { args.pattern.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in characters"),
+    };
+
+    // A null character set tells Polars to strip whitespace
+    let matches = if chars_str.is_empty() {
+        lit(Null {})
+    } else {
+        lit(chars_str)
+    };
+
+    let expr = expr_stack.pop().unwrap();
+    expr_stack.push(expr.str().strip_chars(matches));
+    FfiResult::success_no_handle()
+}
+
+pub fn expr_str_strip_prefix(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const StringArgs) };
+
+    if expr_stack.is_empty() {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            "str_strip_prefix requires 1 expression on stack",
+        );
+    }
+
+    let prefix_str = match unsafe { args.pattern.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in prefix"),
+    };
+
+    let expr = expr_stack.pop().unwrap();
+    expr_stack.push(expr.str().strip_prefix(lit(prefix_str)));
+    FfiResult::success_no_handle()
+}
+
+pub fn expr_str_strip_suffix(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const StringArgs) };
+
+    if expr_stack.is_empty() {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            "str_strip_suffix requires 1 expression on stack",
+        );
+    }
+
+    let suffix_str = match unsafe { args.pattern.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in suffix"),
+    };
+
+    let expr = expr_stack.pop().unwrap();
+    expr_stack.push(expr.str().strip_suffix(lit(suffix_str)));
+    FfiResult::success_no_handle()
+}
+
+/// Regex extraction - returns the capture group, null where the pattern does not match
+/// The pattern is compiled when the plan runs, so an invalid regex fails at collect time
+pub fn expr_str_extract(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const ExtractArgs) };
+
+    if expr_stack.is_empty() {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            "str_extract requires 1 expression on stack",
+        );
+    }
+
+    let pattern_str = match unsafe { args.pattern.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in pattern"),
+    };
+
+    let expr = expr_stack.pop().unwrap();
+    expr_stack.push(expr.str().extract(lit(pattern_str), args.group));
+    FfiResult::success_no_handle()
+}
+
+/// Row-wise string concatenation of the top `count` expressions; non-string inputs are cast
+pub fn expr_concat_str(ctx: &ExecutionContext) -> FfiResult {
+    let expr_stack = unsafe { &mut *ctx.expr_stack };
+    let args = unsafe { &*(ctx.operation_args as *const ConcatStrArgs) };
+
+    if args.count == 0 || expr_stack.len() < args.count {
+        return FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!("concat_str requires {} expressions on stack", args.count.max(1)),
+        );
+    }
+
+    let separator = match unsafe { args.separator.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in separator"),
+    };
+
+    let exprs = expr_stack.split_off(expr_stack.len() - args.count);
+    expr_stack.push(concat_str(exprs, separator, args.ignore_nulls));
+    FfiResult::success_no_handle()
+}
+
+/// Aggregate a string column (per group inside agg) into one separated string, skipping nulls
+pub fn expr_str_join(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const StringArgs) };
+    let separator = match unsafe { args.pattern.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in separator"),
+    };
+    unary_expr_op(ctx, "str_join", |expr| expr.str().join(separator, true))
+}
+
+// List operations - Polars rejects non-list inputs with a dtype error at collect time
+pub fn expr_list_len(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "list_len", |expr| expr.list().len())
+}
+
+pub fn expr_list_contains(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "list_contains", |list, item| list.list().contains(item))
+}
+
+/// Element at the index on the stack; out-of-bounds indices give null rather than an error
+pub fn expr_list_get(ctx: &ExecutionContext) -> FfiResult {
+    binary_expr_op(ctx, "list_get", |list, index| list.list().get(index, true))
+}
+
+pub fn expr_list_first(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "list_first", |expr| expr.list().first())
+}
+
+pub fn expr_list_last(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "list_last", |expr| expr.list().last())
+}
+
+// Struct operations
+pub fn expr_struct_field(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const StringArgs) };
+    let name = match unsafe { args.pattern.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in field name"),
+    };
+    unary_expr_op(ctx, "struct_field", |expr| expr.struct_().field_by_name(name))
+}
+
+// Temporal operations - Polars rejects non-temporal inputs with a dtype error at collect time
+pub fn expr_dt_year(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "year", |expr| expr.dt().year())
+}
+
+pub fn expr_dt_month(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "month", |expr| expr.dt().month())
+}
+
+pub fn expr_dt_day(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "day", |expr| expr.dt().day())
+}
+
+pub fn expr_dt_hour(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "hour", |expr| expr.dt().hour())
+}
+
+pub fn expr_dt_minute(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "minute", |expr| expr.dt().minute())
+}
+
+pub fn expr_dt_second(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "second", |expr| expr.dt().second())
+}
+
+pub fn expr_dt_weekday(ctx: &ExecutionContext) -> FfiResult {
+    unary_expr_op(ctx, "weekday", |expr| expr.dt().weekday())
+}
+
+/// Truncate timestamps to a duration interval - the result keeps the input's temporal dtype
+pub fn expr_dt_truncate(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const StringArgs) };
+
+    let every = match unsafe { args.pattern.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in duration"),
+    };
+
+    unary_expr_op(ctx, "dt_truncate", |expr| expr.dt().truncate(lit(every)))
+}
+
+/// String to temporal parsing - str.to_date / str.to_datetime with an explicit format
+pub fn expr_strptime(ctx: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(ctx.operation_args as *const StrptimeArgs) };
+
+    let format = match unsafe { args.format.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in format"),
+    };
+    let dtype = match decode_data_type(args.dtype) {
+        Ok(dt) => dt,
+        Err(err) => return err,
+    };
+    let options = StrptimeOptions {
+        format: Some(format.into()),
+        strict: args.strict,
+        ..Default::default()
+    };
+
+    match dtype {
+        DataType::Date => unary_expr_op(ctx, "strptime", |expr| expr.str().to_date(options)),
+        DataType::Datetime(time_unit, _) => unary_expr_op(ctx, "strptime", |expr| {
+            expr.str()
+                .to_datetime(Some(time_unit), None, options, lit("raise"))
+        }),
+        other => FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            &format!("strptime target must be Date or Datetime, got {}", other),
+        ),
+    }
+}
+
 /// SQL expression parsing - uses polars_sql::sql_expr to parse individual expressions
 pub fn expr_sql(ctx: &ExecutionContext) -> FfiResult {
     use crate::SqlExprArgs;
@@ -0,0 +1,55 @@
+use std::collections::HashMap;
+use std::os::raw::c_int;
+use std::sync::{Mutex, MutexGuard, OnceLock};
+
+/// Live handles and the number of Go owners sharing each one
+/// A handle is registered with one owner when it is created; release_dataframe frees the
+/// underlying data only when the last owner releases it, and rejects unknown handles
+/// instead of freeing them twice.
+static HANDLE_REFS: OnceLock<Mutex<HashMap<usize, usize>>> = OnceLock::new();
+
+fn handle_refs() -> MutexGuard<'static, HashMap<usize, usize>> {
+    HANDLE_REFS
+        .get_or_init(|| Mutex::new(HashMap::new()))
+        .lock()
+        .unwrap_or_else(|poisoned| poisoned.into_inner())
+}
+
+/// Record a newly created handle with a single owner
+pub(crate) fn register_handle(handle: usize) {
+    if handle != 0 {
+        handle_refs().insert(handle, 1);
+    }
+}
+
+/// Drop one owner of a handle
+/// Returns None for unknown (never registered or already freed) handles, otherwise
+/// whether the caller released the last owner and must free the data
+pub(crate) fn unregister_handle_ref(handle: usize) -> Option<bool> {
+    let mut refs = handle_refs();
+    let count = refs.get_mut(&handle)?;
+    *count -= 1;
+    if *count > 0 {
+        return Some(false);
+    }
+    refs.remove(&handle);
+    Some(true)
+}
+
+/// Add an owner to a live handle so it survives until every owner has released it
+#[no_mangle]
+pub extern "C" fn retain_handle(handle: usize) -> c_int {
+    match handle_refs().get_mut(&handle) {
+        Some(count) => {
+            *count += 1;
+            0
+        }
+        None => 1, // Unknown or already freed handle
+    }
+}
+
+/// Number of owners of a handle (0 once it has been freed)
+#[no_mangle]
+pub extern "C" fn handle_ref_count(handle: usize) -> usize {
+    handle_refs().get(&handle).copied().unwrap_or(0)
+}
@@ -1,8 +1,19 @@
 use crate::{
-    ExecutionContext, FfiResult, PolarsHandle, RawStr, 
-    ERROR_INVALID_UTF8, ERROR_POLARS_OPERATION,
+    decode_data_type_array, ExecutionContext, FfiResult, PolarsHandle, RawStr, 
+    ERROR_INVALID_UTF8, ERROR_IO, ERROR_NULL_ARGS, ERROR_NULL_HANDLE, ERROR_POLARS_OPERATION,
 };
-use polars::prelude::{LazyFrame, LazyCsvReader, ScanArgsParquet, LazyFileListReader};
+use polars::prelude::{
+    col, concat_lf_diagonal, lit, Column, CsvParseOptions, CsvReadOptions, CsvWriter, DataFrame,
+    DataType, Expr, IntoLazy, IpcCompression, IpcWriter, JsonFormat, JsonReader, JsonWriter,
+    LazyCsvReader, LazyFileListReader, LazyFrame, LazyJsonLineReader, NullValues, PlSmallStr,
+    PolarsResult, ScanArgsIpc, ScanArgsParquet, Schema, SchemaRef, SerReader, SerWriter, UnionArgs,
+};
+use crate::dataframe::optional_raw_str_array_to_vec;
+use std::fs::File;
+use std::io::{BufWriter, Cursor, Write};
+use std::num::NonZeroUsize;
+use std::os::raw::c_int;
+use std::sync::Arc;
 
 /// Helper function to convert RawStr array to Vec<String>
 unsafe fn raw_str_array_to_vec(
@@ -26,12 +37,33 @@ unsafe fn raw_str_array_to_vec(
     Ok(result)
 }
 
+/// CSV parsing options shared by every CSV reader (mirrors Go CSVReadConfig)
+#[repr(C)]
+pub struct CsvConfigArgs {
+    pub has_header: bool,              // Whether CSV has header row
+    pub separator: u8,                 // Field separator byte (0 = ',')
+    pub quote_char: u8,                // Quote byte (0 = '"')
+    pub comment: u8,                   // Comment line prefix byte (0 = none)
+    pub null_values: *const RawStr,    // Tokens parsed as null (null = none)
+    pub null_value_count: usize,       // Number of null tokens
+    pub skip_rows: usize,              // Lines skipped before the header
+    pub skip_rows_after_header: usize, // Rows skipped after the header
+    pub n_rows: usize,                 // Maximum rows to read (0 = all)
+    pub infer_schema_length: usize,    // Rows used to infer dtypes (0 = all rows)
+    pub override_names: *const RawStr, // Columns whose dtype is forced (null = none)
+    pub override_dtypes: *const u32,   // Bit-packed dtype per override name
+    pub override_count: usize,         // Number of dtype overrides
+    pub decimal_comma: bool,           // Parse "1.234,56" style numbers as floats
+    pub decimal_comma_columns: *const RawStr, // Columns with '.' thousands separators (null = none)
+    pub decimal_comma_column_count: usize,    // Number of such columns
+}
+
 /// Arguments for reading CSV files
 #[repr(C)]
 pub struct ReadCsvArgs {
-    pub path: RawStr,     // File path using zero-copy RawStr
-    pub has_header: bool, // Whether CSV has header row
-    pub with_glob: bool,  // Whether to expand glob patterns
+    pub path: RawStr,       // File path using zero-copy RawStr
+    pub with_glob: bool,    // Whether to expand glob patterns
+    pub csv: CsvConfigArgs, // Parsing options
 }
 
 /// Arguments for reading Parquet files
@@ -55,16 +87,438 @@ pub fn dispatch_read_csv(_handle: PolarsHandle, context: &ExecutionContext) -> F
         Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in path"),
     };
 
+    match scan_csv(path_str, &args.csv) {
+        Ok(lazy_frame) => FfiResult::success_lazy(lazy_frame),
+        Err(err) => err,
+    }
+}
+
+/// Scan a CSV file lazily, converting decimal-comma columns when requested
+fn scan_csv(path: &str, config: &CsvConfigArgs) -> Result<LazyFrame, FfiResult> {
+    let separator = csv_separator(config)?;
+    let null_values = unsafe { csv_null_values(config) }?;
+    let overrides = unsafe { csv_dtype_overrides(config) }?;
+
     // Use LazyCsvReader with configurable options - return LazyFrame for lazy evaluation
-    match LazyCsvReader::new(path_str)
-        .with_has_header(args.has_header) // Configurable header detection
+    let lazy_frame = LazyCsvReader::new(path)
+        .with_has_header(config.has_header) // Configurable header detection
+        .with_separator(separator)
+        .with_quote_char(Some(csv_quote_char(config.quote_char)))
+        .with_comment_prefix(csv_comment_prefix(config.comment))
+        .with_null_values(null_values)
+        .with_skip_rows(config.skip_rows)
+        .with_skip_rows_after_header(config.skip_rows_after_header)
+        .with_n_rows((config.n_rows > 0).then_some(config.n_rows))
+        .with_infer_schema_length(csv_infer_schema_length(config))
+        .with_dtype_overwrite(overrides)
+        .with_decimal_comma(config.decimal_comma)
+        .finish()
+        .map_err(|e| FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()))?;
+
+    convert_decimal_comma_columns(lazy_frame, config)
+}
+
+/// Resolve the separator byte argument (0 = ','), rejecting ',' with decimal commas
+fn csv_separator(config: &CsvConfigArgs) -> Result<u8, FfiResult> {
+    let separator = if config.separator != 0 {
+        config.separator
+    } else {
+        b','
+    };
+    if config.decimal_comma && separator == b',' {
+        return Err(FfiResult::error(
+            ERROR_POLARS_OPERATION,
+            "decimal_comma cannot be combined with ',' as the field separator",
+        ));
+    }
+    Ok(separator)
+}
+
+/// Resolve a quote byte argument (0 = '"')
+fn csv_quote_char(quote_char: u8) -> u8 {
+    if quote_char != 0 {
+        quote_char
+    } else {
+        b'"'
+    }
+}
+
+/// Resolve a comment byte argument into a comment line prefix (0 = none)
+fn csv_comment_prefix(comment: u8) -> Option<PlSmallStr> {
+    (comment != 0).then(|| (comment as char).to_string().into())
+}
+
+/// Convert the null token array argument into NullValues applied to every column
+unsafe fn csv_null_values(config: &CsvConfigArgs) -> Result<Option<NullValues>, FfiResult> {
+    if config.null_values.is_null() || config.null_value_count == 0 {
+        return Ok(None);
+    }
+    let tokens = raw_str_array_to_vec(config.null_values, config.null_value_count)
+        .map_err(|msg| FfiResult::error(ERROR_INVALID_UTF8, msg))?;
+    Ok(Some(NullValues::AllColumns(
+        tokens.into_iter().map(PlSmallStr::from).collect(),
+    )))
+}
+
+/// Resolve the inference row count argument (0 = infer from every row)
+fn csv_infer_schema_length(config: &CsvConfigArgs) -> Option<usize> {
+    (config.infer_schema_length > 0).then_some(config.infer_schema_length)
+}
+
+/// Convert the dtype override arrays into a schema of forced column dtypes
+/// Columns not listed keep their inferred dtype
+unsafe fn csv_dtype_overrides(config: &CsvConfigArgs) -> Result<Option<SchemaRef>, FfiResult> {
+    if config.override_names.is_null() || config.override_count == 0 {
+        return Ok(None);
+    }
+    let names = raw_str_array_to_vec(config.override_names, config.override_count)
+        .map_err(|msg| FfiResult::error(ERROR_INVALID_UTF8, msg))?;
+    let dtypes = decode_data_type_array(config.override_dtypes, config.override_count)?;
+    if dtypes.len() != names.len() {
+        return Err(FfiResult::error(
+            ERROR_NULL_ARGS,
+            "Missing dtypes for schema overrides",
+        ));
+    }
+
+    let mut schema = Schema::with_capacity(names.len());
+    for (name, dtype) in names.into_iter().zip(dtypes) {
+        schema.with_column(name.into(), dtype);
+    }
+    Ok(Some(Arc::new(schema)))
+}
+
+/// Dataset member formats (must match DATASET_FORMAT_* in firn.h)
+pub const DATASET_FORMAT_CSV: u8 = 0;
+pub const DATASET_FORMAT_PARQUET: u8 = 1;
+
+/// Arguments for reading a mixed CSV/Parquet dataset
+#[repr(C)]
+pub struct ReadDatasetArgs {
+    pub paths: *const RawStr, // Files to read, in concat order
+    pub formats: *const u8,   // DATASET_FORMAT_* per file
+    pub count: usize,         // Number of files
+    pub csv: CsvConfigArgs,   // Options for the CSV files
+}
+
+/// Dispatch function for reading a dataset of CSV and Parquet files
+/// Files are scanned lazily and combined with a diagonal concat so schemas are aligned by name
+pub fn dispatch_read_dataset(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const ReadDatasetArgs) };
+
+    if args.count == 0 || args.formats.is_null() {
+        return FfiResult::error(ERROR_NULL_ARGS, "Dataset requires at least one file");
+    }
+
+    let paths = match unsafe { raw_str_array_to_vec(args.paths, args.count) } {
+        Ok(paths) => paths,
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+    let formats = unsafe { std::slice::from_raw_parts(args.formats, args.count) };
+
+    let mut frames = Vec::with_capacity(paths.len());
+    for (path, format) in paths.iter().zip(formats) {
+        let lazy_frame = match *format {
+            DATASET_FORMAT_CSV => match scan_csv(path, &args.csv) {
+                Ok(lf) => lf,
+                Err(err) => return err,
+            },
+            DATASET_FORMAT_PARQUET => {
+                match LazyFrame::scan_parquet(path.as_str(), ScanArgsParquet::default()) {
+                    Ok(lf) => lf,
+                    Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+                }
+            }
+            other => {
+                return FfiResult::error(
+                    ERROR_POLARS_OPERATION,
+                    &format!("Unknown dataset format {} for {}", other, path),
+                )
+            }
+        };
+        frames.push(lazy_frame);
+    }
+
+    match concat_lf_diagonal(frames, UnionArgs::default()) {
+        Ok(lazy_frame) => FfiResult::success_lazy(lazy_frame),
+        Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    }
+}
+
+/// Arguments for reading CSV data from an in-memory buffer
+#[repr(C)]
+pub struct ReadCsvBytesArgs {
+    pub data: *const u8,    // CSV bytes (borrowed for the duration of the call)
+    pub len: usize,         // Number of bytes
+    pub csv: CsvConfigArgs, // Parsing options
+}
+
+/// Dispatch function for reading CSV from a byte buffer
+/// The buffer is parsed eagerly because it is only valid while the operations execute
+pub fn dispatch_read_csv_bytes(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const ReadCsvBytesArgs) };
+    let config = &args.csv;
+
+    let data: &[u8] = if args.data.is_null() || args.len == 0 {
+        &[]
+    } else {
+        unsafe { std::slice::from_raw_parts(args.data, args.len) }
+    };
+
+    let separator = match csv_separator(config) {
+        Ok(separator) => separator,
+        Err(err) => return err,
+    };
+    let null_values = match unsafe { csv_null_values(config) } {
+        Ok(null_values) => null_values,
+        Err(err) => return err,
+    };
+    let overrides = match unsafe { csv_dtype_overrides(config) } {
+        Ok(overrides) => overrides,
+        Err(err) => return err,
+    };
+
+    let parse_options = CsvParseOptions::default()
+        .with_separator(separator)
+        .with_quote_char(Some(csv_quote_char(config.quote_char)))
+        .with_comment_prefix(csv_comment_prefix(config.comment))
+        .with_null_values(null_values)
+        .with_decimal_comma(config.decimal_comma);
+    let df = match CsvReadOptions::default()
+        .with_has_header(config.has_header)
+        .with_skip_rows(config.skip_rows)
+        .with_skip_rows_after_header(config.skip_rows_after_header)
+        .with_n_rows((config.n_rows > 0).then_some(config.n_rows))
+        .with_infer_schema_length(csv_infer_schema_length(config))
+        .with_schema_overwrite(overrides)
+        .with_parse_options(parse_options)
+        .into_reader_with_file_handle(Cursor::new(data))
         .finish()
     {
+        Ok(df) => df,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+
+    match convert_decimal_comma_columns(df.lazy(), config) {
         Ok(lazy_frame) => FfiResult::success_lazy(lazy_frame),
+        Err(err) => err,
+    }
+}
+
+/// In-memory column dtypes (matching GO_COLUMN_* in firn.h)
+pub const GO_COLUMN_INT64: u32 = 0;
+pub const GO_COLUMN_FLOAT64: u32 = 1;
+pub const GO_COLUMN_STRING: u32 = 2;
+pub const GO_COLUMN_BOOL: u32 = 3;
+
+/// A column of values copied from Go slices
+/// Only the values array matching dtype is set; all arrays hold len entries
+#[repr(C)]
+pub struct GoColumn {
+    pub name: RawStr,           // Column name
+    pub dtype: u32,             // GO_COLUMN_* dtype
+    pub ints: *const i64,       // GO_COLUMN_INT64 values
+    pub floats: *const f64,     // GO_COLUMN_FLOAT64 values
+    pub strings: *const RawStr, // GO_COLUMN_STRING values
+    pub bools: *const bool,     // GO_COLUMN_BOOL values
+    pub valid: *const bool,     // Per-row validity (null = no nulls)
+    pub len: usize,             // Number of rows
+}
+
+/// Arguments for building a DataFrame from Go columns
+#[repr(C)]
+pub struct FromColumnsArgs {
+    pub columns: *const GoColumn, // Columns in frame order
+    pub column_count: usize,      // Number of columns
+}
+
+/// Dispatch function for building a DataFrame from Go slices
+/// The values are copied eagerly because the Go buffers are only valid while the operations execute
+pub fn dispatch_from_columns(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const FromColumnsArgs) };
+    if args.columns.is_null() || args.column_count == 0 {
+        return FfiResult::error(ERROR_NULL_ARGS, "No columns provided");
+    }
+
+    let go_columns = unsafe { std::slice::from_raw_parts(args.columns, args.column_count) };
+    let mut columns = Vec::with_capacity(go_columns.len());
+    for go_column in go_columns {
+        match unsafe { go_column_to_column(go_column) } {
+            Ok(column) => columns.push(column),
+            Err((code, msg)) => return FfiResult::error(code, &msg),
+        }
+    }
+
+    match DataFrame::new(columns) {
+        Ok(df) => FfiResult::success_lazy(df.lazy()),
         Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
     }
 }
 
+/// Convert one Go column into a Polars column, applying its validity mask
+unsafe fn go_column_to_column(go_column: &GoColumn) -> Result<Column, (c_int, String)> {
+    let name = go_column.name.as_str().map_err(|_| {
+        (
+            ERROR_INVALID_UTF8,
+            "Invalid UTF-8 in column name".to_string(),
+        )
+    })?;
+    let valid = if go_column.valid.is_null() {
+        None
+    } else {
+        Some(std::slice::from_raw_parts(go_column.valid, go_column.len))
+    };
+
+    let column = match go_column.dtype {
+        GO_COLUMN_INT64 => {
+            let values = go_column_values(go_column.ints, go_column.len);
+            Column::new(name.into(), with_validity(values.iter().copied(), valid))
+        }
+        GO_COLUMN_FLOAT64 => {
+            let values = go_column_values(go_column.floats, go_column.len);
+            Column::new(name.into(), with_validity(values.iter().copied(), valid))
+        }
+        GO_COLUMN_BOOL => {
+            let values = go_column_values(go_column.bools, go_column.len);
+            Column::new(name.into(), with_validity(values.iter().copied(), valid))
+        }
+        GO_COLUMN_STRING => {
+            let raw_strs = go_column_values(go_column.strings, go_column.len);
+            let mut values = Vec::with_capacity(raw_strs.len());
+            for raw_str in raw_strs {
+                values.push(raw_str.as_str().map_err(|_| {
+                    (
+                        ERROR_INVALID_UTF8,
+                        format!("Invalid UTF-8 in column {name}"),
+                    )
+                })?);
+            }
+            Column::new(name.into(), with_validity(values.into_iter(), valid))
+        }
+        dtype => {
+            return Err((
+                ERROR_POLARS_OPERATION,
+                format!("Unsupported dtype {dtype} for column {name}"),
+            ))
+        }
+    };
+    Ok(column)
+}
+
+/// Borrow a Go values array, treating a null pointer as empty
+unsafe fn go_column_values<'a, T>(values: *const T, len: usize) -> &'a [T] {
+    if values.is_null() || len == 0 {
+        &[]
+    } else {
+        std::slice::from_raw_parts(values, len)
+    }
+}
+
+/// Pair values with an optional validity mask
+fn with_validity<T>(values: impl Iterator<Item = T>, valid: Option<&[bool]>) -> Vec<Option<T>> {
+    match valid {
+        Some(valid) => values
+            .zip(valid)
+            .map(|(value, &ok)| ok.then_some(value))
+            .collect(),
+        None => values.map(Some).collect(),
+    }
+}
+
+/// Convert decimal-comma string columns to Float64
+/// Polars' decimal_comma parsing does not understand '.' thousands separators, so columns
+/// like "1.234,56" are read as strings and converted here: the listed columns (which the
+/// Go side forces to String) and, with decimal_comma set, every detected column. The cast
+/// is strict, so a listed value that is not a number fails the query instead of silently
+/// becoming null.
+fn convert_decimal_comma_columns(
+    lazy_frame: LazyFrame,
+    config: &CsvConfigArgs,
+) -> Result<LazyFrame, FfiResult> {
+    let mut columns = unsafe {
+        optional_raw_str_array_to_vec(config.decimal_comma_columns, config.decimal_comma_column_count)
+    }
+    .map_err(|msg| FfiResult::error(ERROR_NULL_ARGS, msg))?;
+    if config.decimal_comma {
+        columns.extend(detect_decimal_comma_columns(&lazy_frame, config)?);
+    }
+    if columns.is_empty() {
+        return Ok(lazy_frame);
+    }
+
+    let casts: Vec<Expr> = columns
+        .iter()
+        .map(|name| {
+            col(name.as_str())
+                .str()
+                .replace_all(lit("."), lit(""), true)
+                .str()
+                .replace(lit(","), lit("."), true)
+                .strict_cast(DataType::Float64)
+                .alias(name.as_str())
+        })
+        .collect();
+    Ok(lazy_frame.with_columns(casts))
+}
+
+/// A decimal-comma number with optional '.' thousands separators, e.g. "-1.234,56"
+const DECIMAL_COMMA_NUMBER: &str = r"^-?\d{1,3}(\.\d{3})*(,\d+)?$";
+
+/// Find inferred String columns whose non-null values are all decimal-comma numbers
+/// Every row is checked, so the input is read once more before the query itself runs.
+/// Columns with a dtype override (including the listed ones) are left alone, and a column
+/// with no non-null values stays String.
+fn detect_decimal_comma_columns(
+    lazy_frame: &LazyFrame,
+    config: &CsvConfigArgs,
+) -> Result<Vec<String>, FfiResult> {
+    let overrides = unsafe { optional_raw_str_array_to_vec(config.override_names, config.override_count) }
+        .map_err(|msg| FfiResult::error(ERROR_NULL_ARGS, msg))?;
+    let schema = lazy_frame
+        .clone()
+        .collect_schema()
+        .map_err(|e| FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()))?;
+    let candidates: Vec<String> = schema
+        .iter()
+        .filter(|(name, dtype)| {
+            **dtype == DataType::String && !overrides.iter().any(|o| o.as_str() == name.as_str())
+        })
+        .map(|(name, _)| name.to_string())
+        .collect();
+    if candidates.is_empty() {
+        return Ok(candidates);
+    }
+
+    let checks: Vec<Expr> = candidates
+        .iter()
+        .map(|name| {
+            col(name.as_str())
+                .str()
+                .contains(lit(DECIMAL_COMMA_NUMBER), true)
+                .all(true)
+                .and(col(name.as_str()).is_not_null().any(true))
+                .alias(name.as_str())
+        })
+        .collect();
+    let matches = lazy_frame
+        .clone()
+        .select(checks)
+        .collect()
+        .map_err(|e| FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()))?;
+
+    let mut columns = Vec::new();
+    for name in candidates {
+        let matched = matches
+            .column(name.as_str())
+            .and_then(|column| column.as_materialized_series().bool().map(|values| values.get(0)))
+            .map_err(|e| FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()))?;
+        if matched == Some(true) {
+            columns.push(name);
+        }
+    }
+    Ok(columns)
+}
+
 /// Dispatch function for reading Parquet
 pub fn dispatch_read_parquet(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
     let args = unsafe { &*(context.operation_args as *const ReadParquetArgs) };
@@ -116,3 +570,189 @@ pub fn dispatch_read_parquet(_handle: PolarsHandle, context: &ExecutionContext)
         Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
     }
 }
+
+/// Arguments for reading Arrow IPC (Feather v2) files
+#[repr(C)]
+pub struct ReadIpcArgs {
+    pub path: RawStr, // File path or glob pattern
+}
+
+/// Dispatch function for reading Arrow IPC files
+/// The file is scanned lazily and keeps the exact dtypes it was written with
+pub fn dispatch_read_ipc(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const ReadIpcArgs) };
+
+    let path = match unsafe { args.path.as_str() } {
+        Ok(s) => s,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in path"),
+    };
+
+    match LazyFrame::scan_ipc(path, ScanArgsIpc::default()) {
+        Ok(lazy_frame) => FfiResult::success_lazy(lazy_frame),
+        Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    }
+}
+
+/// Arguments for reading JSON and NDJSON files
+#[repr(C)]
+pub struct ReadJsonArgs {
+    pub pattern: RawStr,            // Path or glob as given, reported when nothing matches
+    pub paths: *const RawStr,       // Files to read (globs already expanded), in concat order
+    pub count: usize,               // Number of files
+    pub infer_schema_length: usize, // Rows used to infer the schema (0 = all rows)
+}
+
+/// Dispatch function for reading JSON files holding an array of row objects
+/// JSON arrays cannot be scanned lazily, so each file is parsed eagerly and then made lazy.
+pub fn dispatch_read_json(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const ReadJsonArgs) };
+    read_json_files(args, |path, infer_schema_length| {
+        let file = File::open(path)?;
+        JsonReader::new(file)
+            .infer_schema_len(infer_schema_length)
+            .finish()
+            .map(|df| df.lazy())
+    })
+}
+
+/// Dispatch function for reading newline-delimited JSON files (one object per line)
+pub fn dispatch_read_ndjson(_handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let args = unsafe { &*(context.operation_args as *const ReadJsonArgs) };
+    read_json_files(args, |path, infer_schema_length| {
+        LazyJsonLineReader::new(path)
+            .with_infer_schema_length(infer_schema_length)
+            .finish()
+    })
+}
+
+/// Read every file in args with `read` and combine them with a diagonal concat, so files
+/// with differing keys are aligned by name and missing keys become nulls
+fn read_json_files<F>(args: &ReadJsonArgs, read: F) -> FfiResult
+where
+    F: Fn(&str, Option<NonZeroUsize>) -> PolarsResult<LazyFrame>,
+{
+    if args.count == 0 {
+        let pattern = unsafe { args.pattern.as_str() }.unwrap_or("");
+        return FfiResult::error(ERROR_POLARS_OPERATION, &format!("no files match {}", pattern));
+    }
+    let paths = match unsafe { raw_str_array_to_vec(args.paths, args.count) } {
+        Ok(paths) => paths,
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+    let infer_schema_length = NonZeroUsize::new(args.infer_schema_length);
+
+    let mut frames = Vec::with_capacity(paths.len());
+    for path in &paths {
+        match read(path, infer_schema_length) {
+            Ok(lf) => frames.push(lf),
+            Err(e) => {
+                return FfiResult::error(ERROR_POLARS_OPERATION, &format!("{}: {}", path, e))
+            }
+        }
+    }
+    if frames.len() == 1 {
+        return FfiResult::success_lazy(frames.remove(0));
+    }
+
+    match concat_lf_diagonal(frames, UnionArgs::default()) {
+        Ok(lazy_frame) => FfiResult::success_lazy(lazy_frame),
+        Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    }
+}
+
+/// Stream an executed DataFrame into a newly created file through `write`
+/// Failures creating, writing or flushing the file are reported as ERROR_IO.
+fn write_dataframe_file<F>(handle: usize, path: RawStr, write: F) -> FfiResult
+where
+    F: FnOnce(&mut BufWriter<File>, &mut DataFrame) -> PolarsResult<()>,
+{
+    if handle == 0 {
+        return FfiResult::error(ERROR_NULL_HANDLE, "Handle cannot be null");
+    }
+    let path = match unsafe { path.as_str() } {
+        Ok(p) => p,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in path"),
+    };
+
+    let mut df = unsafe { &*(handle as *const DataFrame) }.clone();
+    let file = match File::create(path) {
+        Ok(file) => file,
+        Err(e) => return FfiResult::error(ERROR_IO, &format!("cannot create {}: {}", path, e)),
+    };
+
+    let mut writer = BufWriter::new(file);
+    let written = write(&mut writer, &mut df).and_then(|_| writer.flush().map_err(Into::into));
+    match written {
+        Ok(()) => FfiResult::success_no_handle(),
+        Err(e) => FfiResult::error(ERROR_IO, &format!("writing {}: {}", path, e)),
+    }
+}
+
+/// Write an executed DataFrame to a CSV file, streaming rows straight to disk
+/// float_precision fixes the digits after the decimal point (0 = shortest round-trip form).
+#[no_mangle]
+pub extern "C" fn write_csv(
+    handle: usize,
+    path: RawStr,
+    include_header: bool,
+    separator: u8,
+    float_precision: usize,
+) -> FfiResult {
+    let separator = if separator != 0 { separator } else { b',' };
+    let float_precision = if float_precision > 0 {
+        Some(float_precision)
+    } else {
+        None
+    };
+
+    write_dataframe_file(handle, path, |writer, df| {
+        CsvWriter::new(writer)
+            .include_header(include_header)
+            .with_separator(separator)
+            .with_float_precision(float_precision)
+            .finish(df)
+    })
+}
+
+/// Write an executed DataFrame to a JSON file
+/// ndjson selects newline-delimited output (one object per line) instead of a single array of
+/// row objects. List and struct columns become JSON arrays and objects.
+#[no_mangle]
+pub extern "C" fn write_json(handle: usize, path: RawStr, ndjson: bool) -> FfiResult {
+    let format = if ndjson {
+        JsonFormat::JsonLines
+    } else {
+        JsonFormat::Json
+    };
+
+    write_dataframe_file(handle, path, |writer, df| {
+        JsonWriter::new(writer).with_json_format(format).finish(df)
+    })
+}
+
+/// IPC compression codecs (must match IPC_COMPRESSION_* in firn.h)
+pub const IPC_COMPRESSION_NONE: u32 = 0;
+pub const IPC_COMPRESSION_LZ4: u32 = 1;
+pub const IPC_COMPRESSION_ZSTD: u32 = 2;
+
+/// Write an executed DataFrame to an Arrow IPC (Feather v2) file
+#[no_mangle]
+pub extern "C" fn write_ipc(handle: usize, path: RawStr, compression: u32) -> FfiResult {
+    let compression = match compression {
+        IPC_COMPRESSION_NONE => None,
+        IPC_COMPRESSION_LZ4 => Some(IpcCompression::LZ4),
+        IPC_COMPRESSION_ZSTD => Some(IpcCompression::ZSTD),
+        other => {
+            return FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!("Unknown IPC compression {}", other),
+            )
+        }
+    };
+
+    write_dataframe_file(handle, path, |writer, df| {
+        IpcWriter::new(writer)
+            .with_compression(compression)
+            .finish(df)
+    })
+}
@@ -3,20 +3,30 @@ use std::ffi::CString;
 use std::os::raw::{c_char, c_int};
 use std::ptr;
 
+use handles::register_handle;
+
 // Module declarations
+mod arrow;
+mod cancel;
 mod dataframe;
 mod execution;
 mod expr;
+mod handles;
 mod io;
 mod opcodes;
+mod reshape;
 mod types;
 
 // Re-export public items
+pub use arrow::*;
+pub use cancel::{cancel_token, free_cancel_token, new_cancel_token};
 pub use dataframe::*;
 pub use execution::{execute_expr_ops, execute_operations, ExecutionContext};
 pub use expr::*;
+pub use handles::{handle_ref_count, retain_handle};
 pub use io::*;
 pub use opcodes::*;
+pub use reshape::*;
 pub use types::*;
 
 // Error codes
@@ -24,6 +34,8 @@ pub const ERROR_NULL_HANDLE: c_int = 1;
 pub const ERROR_NULL_ARGS: c_int = 2;
 pub const ERROR_INVALID_UTF8: c_int = 3;
 pub const ERROR_POLARS_OPERATION: c_int = 4;
+pub const ERROR_IO: c_int = 5;
+pub const ERROR_CANCELLED: c_int = 6;
 
 /// Zero-copy string representation for FFI
 #[repr(C)]
@@ -63,6 +75,7 @@ impl FfiResult {
     pub fn success(df: DataFrame) -> Self {
         let boxed_df = Box::new(df);
         let handle = Box::into_raw(boxed_df) as usize;
+        register_handle(handle);
         Self {
             polars_handle: PolarsHandle::new(handle, ContextType::DataFrame),
             error_code: 0,
@@ -75,6 +88,7 @@ impl FfiResult {
     pub fn success_lazy(lazy_frame: LazyFrame) -> Self {
         let boxed_lazy = Box::new(lazy_frame);
         let handle = Box::into_raw(boxed_lazy) as usize;
+        register_handle(handle);
         Self {
             polars_handle: PolarsHandle::new(handle, ContextType::LazyFrame),
             error_code: 0,
@@ -87,6 +101,7 @@ impl FfiResult {
     pub fn success_lazy_group_by(lazy_group_by: LazyGroupBy) -> Self {
         let boxed_lazy_group_by = Box::new(lazy_group_by);
         let handle = Box::into_raw(boxed_lazy_group_by) as usize;
+        register_handle(handle);
         Self {
             polars_handle: PolarsHandle::new(handle, ContextType::LazyGroupBy),
             error_code: 0,
@@ -169,6 +184,30 @@ pub struct JoinArgs {
     pub how: JoinType,           // Join type (inner, left, etc.)
     pub suffix: RawStr,          // Optional suffix for duplicate columns
     pub coalesce: bool,          // Whether to coalesce join columns (default false)
+    pub indicator: bool,         // Whether to add a _merge column naming each row's source side
+    pub other_context_type: u32, // Context type of the right handle
+    pub other_ops: *const Operation, // Right frame's pending operations (null if none)
+    pub other_op_count: usize,
+}
+
+/// As-of join strategies (must match ASOF_STRATEGY_* in firn.h)
+pub const ASOF_STRATEGY_BACKWARD: u32 = 0;
+pub const ASOF_STRATEGY_FORWARD: u32 = 1;
+pub const ASOF_STRATEGY_NEAREST: u32 = 2;
+
+/// Arguments for as-of join operations
+#[repr(C)]
+pub struct AsofJoinArgs {
+    pub other_handle: usize,     // Handle to the right DataFrame
+    pub other_context_type: u32, // Context type of the right handle
+    pub left_on: RawStr,         // Left asof key (must be sorted)
+    pub right_on: RawStr,        // Right asof key (must be sorted)
+    pub by: *const RawStr,       // Optional grouping columns matched exactly (null if none)
+    pub by_count: usize,
+    pub strategy: u32,           // ASOF_STRATEGY_* constant
+    pub tolerance: RawStr,       // Optional max key distance: number or duration string (empty = none)
+    pub other_ops: *const Operation, // Right frame's pending operations (null if none)
+    pub other_op_count: usize,
 }
 
 /// Helper function to create RawStr from Go string data
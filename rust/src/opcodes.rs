@@ -2,7 +2,7 @@ use crate::RawStr;
 /// Opcodes for DataFrame and Expression operations
 /// These replace function pointers for cleaner dispatch and context handling
 ///
-/// IMPORTANT: When adding/changing opcodes here, update pkg/polars/opcodes.go
+/// IMPORTANT: When adding/changing opcodes here, update polars/opcodes.go
 /// to match these exact values! The Go constants must stay in sync.
 use std::os::raw::c_int;
 
@@ -27,6 +27,31 @@ pub enum OpCode {
     Limit = 15,
     Query = 16,
     Join = 17,
+    Unpivot = 18,
+    ReadCsvBytes = 19,
+    Fetch = 20,
+    GatherEvery = 21,
+    SortExpr = 22,
+    ReadDataset = 23,
+    Unique = 24,
+    CorrMatrix = 25,
+    DropNulls = 26,
+    Tail = 27,
+    Drop = 28,
+    Rename = 29,
+    Pivot = 30,
+    Sample = 31,
+    Slice = 32,
+    Reverse = 33,
+    JoinAsof = 34,
+    ReadJson = 35,
+    ReadNdjson = 36,
+    ReadIpc = 37,
+    FromColumns = 38,
+    NullCount = 39,
+    Unnest = 40,
+    ValueCounts = 41,
+    TopK = 42,
 
     // Expression operations (stack-based)
     ExprColumn = 100,
@@ -63,6 +88,12 @@ pub enum OpCode {
     ExprStrToLowercase = 131,
     ExprStrToUppercase = 132,
     ExprSql = 133,
+    ExprDot = 134,
+    ExprHash = 135,
+    ExprQuantile = 136,
+    ExprQuantiles = 137,
+    ExprWeightedMean = 138,
+    ExprClipQuantile = 139,
 
     // Window function operations
     ExprOver = 140,       // Applies window context to previous expression
@@ -80,6 +111,108 @@ pub enum OpCode {
     // Cast operations
     ExprCast = 160,       // Cast expression to specified data type
 
+    // Additional comparison operations
+    ExprGe = 170,         // Greater than or equal
+    ExprLe = 171,         // Less than or equal
+    ExprNe = 172,         // Not equal
+    ExprIsIn = 173,       // Membership in a literal set
+
+    // Null handling operations
+    ExprFillNull = 180,     // Replace nulls with another expression
+    ExprForwardFill = 181,  // Fill nulls with the previous non-null value
+    ExprBackwardFill = 182, // Fill nulls with the next non-null value
+    ExprInterpolate = 183,  // Fill nulls linearly between the surrounding values
+    ExprCoalesce = 184,     // First non-null value across several expressions
+
+    // Numeric operations
+    ExprAbs = 190,        // Absolute value
+    ExprRound = 191,      // Round to a number of decimals
+    ExprFloor = 192,      // Round down to an integer value
+    ExprCeil = 193,       // Round up to an integer value
+    ExprPow = 194,        // Raise to the power of another expression
+    ExprSqrt = 195,       // Square root
+    ExprExp = 196,        // Natural exponential
+    ExprLog = 197,        // Logarithm with an explicit base
+    ExprMod = 198,        // Remainder of division
+    ExprClip = 199,       // Bound values to optional lower/upper expressions
+
+    // Additional string operations
+    ExprStrStripChars = 200,  // Strip characters (or whitespace) from both ends
+    ExprStrStripPrefix = 201, // Remove a literal prefix
+    ExprStrStripSuffix = 202, // Remove a literal suffix
+    ExprStrExtract = 203,     // Extract a regex capture group
+    ExprConcatStr = 204,      // Join several expressions row-wise with a separator
+    ExprStrJoin = 205,        // Aggregate all values into one separated string
+
+    // Temporal operations
+    ExprDtYear = 210,
+    ExprDtMonth = 211,
+    ExprDtDay = 212,
+    ExprDtHour = 213,
+    ExprDtMinute = 214,
+    ExprDtSecond = 215,
+    ExprDtWeekday = 216,  // ISO weekday: Monday = 1 ... Sunday = 7
+    ExprStrptime = 217,   // Parse strings into Date/Datetime with a strptime format
+    ExprDtTruncate = 218, // Truncate to a duration interval (e.g. "1h")
+
+    // Cumulative operations (row-preserving)
+    ExprCumsum = 220,
+    ExprCummax = 221,
+    ExprCummin = 222,
+    ExprCumprod = 223,
+
+    // Row offset operations
+    ExprShift = 225,     // Shift values by n rows (nulls fill the boundary)
+    ExprPctChange = 226, // Percentage change from n rows earlier
+
+    // Positional aggregations (u32 row index within the group or frame)
+    ExprArgMax = 230,
+    ExprArgMin = 231,
+
+    // Distribution shape aggregations
+    ExprSkew = 232,
+    ExprKurtosis = 233,
+
+    // Multiplicative aggregations
+    ExprProduct = 235,
+
+    // Float predicates (NaN and infinity are distinct from null)
+    ExprIsNaN = 240,
+    ExprIsNotNaN = 241,
+    ExprIsFinite = 242,
+    ExprIsInfinite = 243,
+
+    // Uniqueness predicates
+    ExprIsUnique = 245,
+    ExprIsDuplicated = 246,
+
+    // Selector refinement
+    ExprExclude = 247,
+
+    // Output name templating
+    ExprNamePrefix = 248,
+    ExprNameSuffix = 249,
+    ExprNameKeep = 250,
+
+    // Row count of the frame or group (SQL COUNT(*))
+    ExprLen = 251,
+
+    // Missing-value aggregations
+    ExprNullCount = 252,
+
+    // List operations
+    ExprListLen = 253,      // Number of elements in each list
+    ExprListContains = 254, // Whether each list contains a value
+    ExprListGet = 255,      // Element at an index (negative counts from the end)
+    ExprListFirst = 256,
+    ExprListLast = 257,
+
+    // Struct operations
+    ExprStructField = 258, // Field of a struct column by name
+
+    // Columns selected by data type
+    ExprDtypeCols = 259,
+
     // Error operation for fluent API error handling
     Error = 999,
 }
@@ -105,6 +238,31 @@ impl OpCode {
             15 => Some(OpCode::Limit),
             16 => Some(OpCode::Query),
             17 => Some(OpCode::Join),
+            18 => Some(OpCode::Unpivot),
+            19 => Some(OpCode::ReadCsvBytes),
+            20 => Some(OpCode::Fetch),
+            21 => Some(OpCode::GatherEvery),
+            22 => Some(OpCode::SortExpr),
+            23 => Some(OpCode::ReadDataset),
+            24 => Some(OpCode::Unique),
+            25 => Some(OpCode::CorrMatrix),
+            26 => Some(OpCode::DropNulls),
+            27 => Some(OpCode::Tail),
+            28 => Some(OpCode::Drop),
+            29 => Some(OpCode::Rename),
+            30 => Some(OpCode::Pivot),
+            31 => Some(OpCode::Sample),
+            32 => Some(OpCode::Slice),
+            33 => Some(OpCode::Reverse),
+            34 => Some(OpCode::JoinAsof),
+            35 => Some(OpCode::ReadJson),
+            36 => Some(OpCode::ReadNdjson),
+            37 => Some(OpCode::ReadIpc),
+            38 => Some(OpCode::FromColumns),
+            39 => Some(OpCode::NullCount),
+            40 => Some(OpCode::Unnest),
+            41 => Some(OpCode::ValueCounts),
+            42 => Some(OpCode::TopK),
             100 => Some(OpCode::ExprColumn),
             101 => Some(OpCode::ExprLiteral),
             102 => Some(OpCode::ExprAdd),
@@ -139,6 +297,12 @@ impl OpCode {
             131 => Some(OpCode::ExprStrToLowercase),
             132 => Some(OpCode::ExprStrToUppercase),
             133 => Some(OpCode::ExprSql),
+            134 => Some(OpCode::ExprDot),
+            135 => Some(OpCode::ExprHash),
+            136 => Some(OpCode::ExprQuantile),
+            137 => Some(OpCode::ExprQuantiles),
+            138 => Some(OpCode::ExprWeightedMean),
+            139 => Some(OpCode::ExprClipQuantile),
             140 => Some(OpCode::ExprOver),
             141 => Some(OpCode::ExprRank),
             142 => Some(OpCode::ExprDenseRank),
@@ -149,6 +313,70 @@ impl OpCode {
             151 => Some(OpCode::ExprThen),
             152 => Some(OpCode::ExprOtherwise),
             160 => Some(OpCode::ExprCast),
+            170 => Some(OpCode::ExprGe),
+            171 => Some(OpCode::ExprLe),
+            172 => Some(OpCode::ExprNe),
+            173 => Some(OpCode::ExprIsIn),
+            180 => Some(OpCode::ExprFillNull),
+            181 => Some(OpCode::ExprForwardFill),
+            182 => Some(OpCode::ExprBackwardFill),
+            183 => Some(OpCode::ExprInterpolate),
+            184 => Some(OpCode::ExprCoalesce),
+            190 => Some(OpCode::ExprAbs),
+            191 => Some(OpCode::ExprRound),
+            192 => Some(OpCode::ExprFloor),
+            193 => Some(OpCode::ExprCeil),
+            194 => Some(OpCode::ExprPow),
+            195 => Some(OpCode::ExprSqrt),
+            196 => Some(OpCode::ExprExp),
+            197 => Some(OpCode::ExprLog),
+            198 => Some(OpCode::ExprMod),
+            199 => Some(OpCode::ExprClip),
+            200 => Some(OpCode::ExprStrStripChars),
+            201 => Some(OpCode::ExprStrStripPrefix),
+            202 => Some(OpCode::ExprStrStripSuffix),
+            203 => Some(OpCode::ExprStrExtract),
+            204 => Some(OpCode::ExprConcatStr),
+            205 => Some(OpCode::ExprStrJoin),
+            210 => Some(OpCode::ExprDtYear),
+            211 => Some(OpCode::ExprDtMonth),
+            212 => Some(OpCode::ExprDtDay),
+            213 => Some(OpCode::ExprDtHour),
+            214 => Some(OpCode::ExprDtMinute),
+            215 => Some(OpCode::ExprDtSecond),
+            216 => Some(OpCode::ExprDtWeekday),
+            217 => Some(OpCode::ExprStrptime),
+            218 => Some(OpCode::ExprDtTruncate),
+            220 => Some(OpCode::ExprCumsum),
+            221 => Some(OpCode::ExprCummax),
+            222 => Some(OpCode::ExprCummin),
+            223 => Some(OpCode::ExprCumprod),
+            225 => Some(OpCode::ExprShift),
+            226 => Some(OpCode::ExprPctChange),
+            230 => Some(OpCode::ExprArgMax),
+            231 => Some(OpCode::ExprArgMin),
+            232 => Some(OpCode::ExprSkew),
+            233 => Some(OpCode::ExprKurtosis),
+            235 => Some(OpCode::ExprProduct),
+            240 => Some(OpCode::ExprIsNaN),
+            241 => Some(OpCode::ExprIsNotNaN),
+            242 => Some(OpCode::ExprIsFinite),
+            243 => Some(OpCode::ExprIsInfinite),
+            245 => Some(OpCode::ExprIsUnique),
+            246 => Some(OpCode::ExprIsDuplicated),
+            247 => Some(OpCode::ExprExclude),
+            248 => Some(OpCode::ExprNamePrefix),
+            249 => Some(OpCode::ExprNameSuffix),
+            250 => Some(OpCode::ExprNameKeep),
+            251 => Some(OpCode::ExprLen),
+            252 => Some(OpCode::ExprNullCount),
+            253 => Some(OpCode::ExprListLen),
+            254 => Some(OpCode::ExprListContains),
+            255 => Some(OpCode::ExprListGet),
+            256 => Some(OpCode::ExprListFirst),
+            257 => Some(OpCode::ExprListLast),
+            258 => Some(OpCode::ExprStructField),
+            259 => Some(OpCode::ExprDtypeCols),
             999 => Some(OpCode::Error),
             _ => None,
         }
@@ -279,6 +507,78 @@ pub struct SortField {
 pub struct SortArgs {
     pub fields: *const SortField,
     pub field_count: c_int,
+    pub maintain_order: bool, // Stable sort: ties keep their original relative order
+}
+
+/// Direction and nulls ordering for one expression sort key
+#[repr(C)]
+#[derive(Clone, Copy)]
+pub struct SortKeyOptions {
+    pub direction: SortDirection,
+    pub nulls_ordering: NullsOrdering,
+}
+
+/// Arguments for expression sorts; the key expressions are on the expression stack
+#[repr(C)]
+pub struct SortExprArgs {
+    pub keys: *const SortKeyOptions,
+    pub key_count: c_int,     // Must equal the number of expressions on the stack
+    pub maintain_order: bool, // Stable sort: ties keep their original relative order
+}
+
+/// Arguments for top-k/bottom-k selection; the key expressions are on the expression stack
+#[repr(C)]
+pub struct TopKArgs {
+    pub k: usize,         // Number of rows to keep
+    pub key_count: c_int, // Must equal the number of expressions on the stack
+    pub bottom: bool,     // Keep the smallest keys instead of the largest
+}
+
+/// Unique keep strategies (must match UNIQUE_KEEP_* in firn.h)
+pub const UNIQUE_KEEP_FIRST: u32 = 0;
+pub const UNIQUE_KEEP_LAST: u32 = 1;
+pub const UNIQUE_KEEP_ANY: u32 = 2;
+pub const UNIQUE_KEEP_NONE: u32 = 3;
+
+/// Arguments for unique operations
+#[repr(C)]
+pub struct UniqueArgs {
+    pub subset: *const RawStr,     // Key columns (null = all columns)
+    pub subset_count: usize,
+    pub keep: u32,                 // UNIQUE_KEEP_* strategy
+    pub order: *const SortField,   // Optional ordering applied before deduplication
+    pub order_count: c_int,
+}
+
+/// Arguments for drop_nulls operations
+#[repr(C)]
+pub struct DropNullsArgs {
+    pub subset: *const RawStr, // Columns checked for nulls (null = all columns)
+    pub subset_count: usize,
+}
+
+/// Arguments for drop operations
+#[repr(C)]
+pub struct DropArgs {
+    pub columns: *const RawStr, // Columns to remove
+    pub column_count: usize,
+}
+
+/// Arguments for unnest operations
+#[repr(C)]
+pub struct UnnestArgs {
+    pub columns: *const RawStr, // Struct columns to expand
+    pub column_count: usize,
+}
+
+/// Arguments for rename operations (parallel old/new name arrays)
+#[repr(C)]
+pub struct RenameArgs {
+    pub existing: *const RawStr, // Current column names
+    pub renamed: *const RawStr,  // New names, same length as existing
+    pub count: usize,            // 0 = rename every column with prefix/suffix
+    pub prefix: RawStr,
+    pub suffix: RawStr,
 }
 
 /// Arguments for limit operations
@@ -287,6 +587,60 @@ pub struct LimitArgs {
     pub n: usize,
 }
 
+/// Arguments for collect operations
+#[repr(C)]
+pub struct CollectArgs {
+    pub streaming: bool,     // Run the plan on the streaming engine
+    pub cancel_token: usize, // Token from new_cancel_token (0 = not cancellable)
+}
+
+/// Arguments for fetch operations
+#[repr(C)]
+pub struct FetchArgs {
+    pub n_rows: usize, // Number of rows to read from each source
+}
+
+/// Arguments for gather_every operations
+#[repr(C)]
+pub struct GatherEveryArgs {
+    pub n: usize,      // Keep every n-th row
+    pub offset: usize, // Position of the first row to keep
+}
+
+/// Arguments for slice operations
+#[repr(C)]
+pub struct SliceArgs {
+    pub offset: i64,   // First row (negative counts from the end)
+    pub length: usize, // Maximum number of rows
+}
+
+/// Arguments for sample operations (exactly one of n and fraction is set)
+#[repr(C)]
+pub struct SampleArgs {
+    pub n: usize,               // Number of rows (0 when sampling by fraction)
+    pub fraction: f64,          // Fraction of rows (0 when sampling by count)
+    pub with_replacement: bool,
+    pub seed: u64,              // Fixed seed for reproducible samples
+}
+
+/// Arguments for value_counts operations
+#[repr(C)]
+pub struct ValueCountsArgs {
+    pub column: RawStr,  // Column whose distinct values are counted
+    pub sort: bool,      // Most frequent first, ties by value
+    pub normalize: bool, // Proportions instead of counts
+}
+
+/// Correlation methods (must match CORR_METHOD_* in firn.h)
+pub const CORR_METHOD_PEARSON: u32 = 0;
+pub const CORR_METHOD_SPEARMAN: u32 = 1;
+
+/// Arguments for correlation matrix operations
+#[repr(C)]
+pub struct CorrMatrixArgs {
+    pub method: u32, // CORR_METHOD_* correlation method
+}
+
 /// Arguments for SQL query operations
 #[repr(C)]
 #[derive(Clone, Copy)]
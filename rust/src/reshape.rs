@@ -0,0 +1,180 @@
+use crate::dataframe::{lazy_frame_from_handle, optional_raw_str_array_to_vec, raw_str_array_to_vec};
+use crate::types::decode_data_type_array;
+use crate::{
+    ExecutionContext, FfiResult, PolarsHandle, RawStr, ERROR_INVALID_UTF8, ERROR_NULL_ARGS,
+    ERROR_POLARS_OPERATION,
+};
+use polars::lazy::frame::pivot::pivot_stable;
+use polars::prelude::{col, dtype_cols, Expr, Selector, UnpivotArgsDSL};
+
+/// Arguments for unpivot (melt) operations
+#[repr(C)]
+pub struct UnpivotArgs {
+    pub id_vars: *const RawStr,     // Identifier columns (null if none)
+    pub id_count: usize,
+    pub value_vars: *const RawStr,  // Columns to unpivot (null if none)
+    pub value_count: usize,
+    pub id_dtypes: *const u32,      // Dtype selector for identifier columns
+    pub id_dtype_count: usize,
+    pub value_dtypes: *const u32,   // Dtype selector for value columns
+    pub value_dtype_count: usize,
+    pub variable_name: RawStr,      // Name of the variable column (empty = "variable")
+    pub value_name: RawStr,         // Name of the value column (empty = "value")
+}
+
+/// Build the selector list for one side of an unpivot from explicit names and a dtype selector
+unsafe fn unpivot_selectors(
+    names: *const RawStr,
+    name_count: usize,
+    dtypes: *const u32,
+    dtype_count: usize,
+) -> Result<Vec<Selector>, FfiResult> {
+    let columns = match optional_raw_str_array_to_vec(names, name_count) {
+        Ok(cols) => cols,
+        Err(msg) => return Err(FfiResult::error(ERROR_NULL_ARGS, msg)),
+    };
+    let dtypes = decode_data_type_array(dtypes, dtype_count)?;
+
+    let mut selectors: Vec<Selector> = columns
+        .iter()
+        .map(|name| Selector::Root(Box::new(col(name.as_str()))))
+        .collect();
+    if !dtypes.is_empty() {
+        selectors.push(Selector::Root(Box::new(dtype_cols(dtypes))));
+    }
+    Ok(selectors)
+}
+
+/// Dispatch function for unpivot operations (wide to long)
+/// An empty value side unpivots every column that is not an identifier
+pub fn dispatch_unpivot(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "unpivot") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const UnpivotArgs) };
+
+    let index = match unsafe {
+        unpivot_selectors(args.id_vars, args.id_count, args.id_dtypes, args.id_dtype_count)
+    } {
+        Ok(selectors) => selectors,
+        Err(err) => return err,
+    };
+    let on = match unsafe {
+        unpivot_selectors(
+            args.value_vars,
+            args.value_count,
+            args.value_dtypes,
+            args.value_dtype_count,
+        )
+    } {
+        Ok(selectors) => selectors,
+        Err(err) => return err,
+    };
+
+    let variable_name = match unsafe { args.variable_name.as_str() } {
+        Ok(s) if !s.is_empty() => Some(s.into()),
+        Ok(_) => None,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in variable name"),
+    };
+    let value_name = match unsafe { args.value_name.as_str() } {
+        Ok(s) if !s.is_empty() => Some(s.into()),
+        Ok(_) => None,
+        Err(_) => return FfiResult::error(ERROR_INVALID_UTF8, "Invalid UTF-8 in value name"),
+    };
+
+    let unpivoted = lazy_frame.unpivot(UnpivotArgsDSL {
+        on,
+        index,
+        variable_name,
+        value_name,
+    });
+
+    FfiResult::success_lazy(unpivoted)
+}
+
+/// Pivot aggregation constants (must match PIVOT_AGG_* in firn.h)
+pub const PIVOT_AGG_FIRST: u32 = 0;
+pub const PIVOT_AGG_LAST: u32 = 1;
+pub const PIVOT_AGG_SUM: u32 = 2;
+pub const PIVOT_AGG_MEAN: u32 = 3;
+pub const PIVOT_AGG_MEDIAN: u32 = 4;
+pub const PIVOT_AGG_MIN: u32 = 5;
+pub const PIVOT_AGG_MAX: u32 = 6;
+pub const PIVOT_AGG_COUNT: u32 = 7;
+
+/// Arguments for pivot operations (long to wide)
+#[repr(C)]
+pub struct PivotArgs {
+    pub index: *const RawStr,  // Row identifier columns (null = all other columns)
+    pub index_count: usize,
+    pub on: *const RawStr,     // Columns whose values become new column names
+    pub on_count: usize,
+    pub values: *const RawStr, // Columns to aggregate into cells (null = all other columns)
+    pub values_count: usize,
+    pub agg: u32,              // PIVOT_AGG_* constant
+}
+
+impl PivotArgs {
+    /// Aggregation applied to the values landing in each cell
+    fn agg_expr(&self) -> Result<Expr, FfiResult> {
+        // Pivot aggregations operate on the cell's values, addressed by the empty column name
+        let element = col("");
+        match self.agg {
+            PIVOT_AGG_FIRST => Ok(element.first()),
+            PIVOT_AGG_LAST => Ok(element.last()),
+            PIVOT_AGG_SUM => Ok(element.sum()),
+            PIVOT_AGG_MEAN => Ok(element.mean()),
+            PIVOT_AGG_MEDIAN => Ok(element.median()),
+            PIVOT_AGG_MIN => Ok(element.min()),
+            PIVOT_AGG_MAX => Ok(element.max()),
+            PIVOT_AGG_COUNT => Ok(element.count()),
+            other => Err(FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!("Unknown pivot aggregation {}", other),
+            )),
+        }
+    }
+}
+
+/// Dispatch function for pivot operations (long to wide)
+/// Pivot output columns depend on the data, so the input is collected first and the result
+/// is an eager DataFrame; rows and new columns keep their order of first appearance.
+pub fn dispatch_pivot(handle: PolarsHandle, context: &ExecutionContext) -> FfiResult {
+    let lazy_frame = match lazy_frame_from_handle(handle, "pivot") {
+        Ok(lf) => lf,
+        Err(err) => return err,
+    };
+
+    let args = unsafe { &*(context.operation_args as *const PivotArgs) };
+
+    let on = match unsafe { raw_str_array_to_vec(args.on, args.on_count) } {
+        Ok(cols) => cols,
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+    let index = match unsafe { optional_raw_str_array_to_vec(args.index, args.index_count) } {
+        Ok(cols) if cols.is_empty() => None,
+        Ok(cols) => Some(cols),
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+    let values = match unsafe { optional_raw_str_array_to_vec(args.values, args.values_count) } {
+        Ok(cols) if cols.is_empty() => None,
+        Ok(cols) => Some(cols),
+        Err(msg) => return FfiResult::error(ERROR_NULL_ARGS, msg),
+    };
+    let agg = match args.agg_expr() {
+        Ok(expr) => expr,
+        Err(err) => return err,
+    };
+
+    let df = match lazy_frame.collect() {
+        Ok(df) => df,
+        Err(e) => return FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    };
+
+    match pivot_stable(&df, on, index, values, false, Some(agg), None) {
+        Ok(pivoted) => FfiResult::success(pivoted),
+        Err(e) => FfiResult::error(ERROR_POLARS_OPERATION, &e.to_string()),
+    }
+}
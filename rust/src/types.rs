@@ -1,4 +1,4 @@
-use crate::{FfiResult, RawStr, ERROR_POLARS_OPERATION};
+use crate::{FfiResult, RawStr, ERROR_INVALID_UTF8, ERROR_POLARS_OPERATION};
 use polars::prelude::*;
 
 /// Arguments for column reference operations
@@ -25,12 +25,34 @@ pub struct StringArgs {
     pub pattern: RawStr, // Pattern/string for operations like contains, starts_with, ends_with
 }
 
+/// Arguments for regex extraction
+#[repr(C)]
+pub struct ExtractArgs {
+    pub pattern: RawStr, // Regular expression
+    pub group: usize,    // Capture group to return (0 = whole match)
+}
+
+/// Arguments for row-wise string concatenation
+#[repr(C)]
+pub struct ConcatStrArgs {
+    pub separator: RawStr,  // Placed between the joined values
+    pub count: usize,       // Expressions taken from the stack, in output order
+    pub ignore_nulls: bool, // Skip null inputs instead of producing a null row
+}
+
 /// Arguments for aggregation operations that need ddof (std, var)
 #[repr(C)]
 pub struct AggregationArgs {
     pub ddof: u8, // Delta degrees of freedom (0=population, 1=sample)
 }
 
+/// Arguments for skew/kurtosis aggregations
+#[repr(C)]
+pub struct MomentArgs {
+    pub fisher: bool, // Kurtosis only: subtract 3 so a normal distribution scores 0
+    pub bias: bool,   // false applies the sample-size bias correction
+}
+
 /// Arguments for count operations
 #[repr(C)]
 pub struct CountArgs {
@@ -45,6 +67,124 @@ pub struct CastArgs {
     pub wrap_numerical: bool, // If true, wrap overflowing numeric values instead of marking invalid
 }
 
+/// Arguments for strptime parsing
+#[repr(C)]
+pub struct StrptimeArgs {
+    pub format: RawStr, // strptime format, e.g. "%Y-%m-%d"
+    pub dtype: u32,     // Bit-packed Date or Datetime target type
+    pub strict: bool,   // Error on unparseable rows instead of producing nulls
+}
+
+/// Arguments for round operations
+#[repr(C)]
+pub struct RoundArgs {
+    pub decimals: u32, // Digits kept after the decimal point
+}
+
+/// Arguments for logarithm operations
+#[repr(C)]
+pub struct LogArgs {
+    pub base: f64, // Logarithm base (positive, not 1)
+}
+
+/// Arguments for clip operations
+#[repr(C)]
+pub struct ClipArgs {
+    pub has_lower: bool, // Lower bound expression is on the stack
+    pub has_upper: bool, // Upper bound expression is on the stack (above lower)
+}
+
+/// Arguments for cumulative operations
+#[repr(C)]
+pub struct CumulativeArgs {
+    pub reverse: bool, // Accumulate from the last row towards the first
+}
+
+/// Arguments for shift operations
+#[repr(C)]
+pub struct ShiftArgs {
+    pub n: i64, // Rows to shift by (negative shifts towards the start)
+}
+
+/// Arguments for coalesce operations
+#[repr(C)]
+pub struct CoalesceArgs {
+    pub count: usize, // Expressions taken from the stack, in priority order
+}
+
+/// Arguments for forward/backward fill operations
+#[repr(C)]
+pub struct FillArgs {
+    pub limit: u32, // Maximum consecutive nulls to fill (0 = unlimited)
+}
+
+impl FillArgs {
+    /// Decode the limit as Polars expects it (None = unlimited)
+    pub fn fill_limit(&self) -> Option<IdxSize> {
+        if self.limit == 0 {
+            None
+        } else {
+            Some(self.limit as IdxSize)
+        }
+    }
+}
+
+/// Arguments for hash operations
+#[repr(C)]
+pub struct HashArgs {
+    pub seed: u64, // Hash seed; equal seeds produce equal hashes
+}
+
+/// Quantile interpolation constants (must match QUANTILE_INTERP_* in firn.h)
+pub const QUANTILE_INTERP_NEAREST: u32 = 0;
+pub const QUANTILE_INTERP_LOWER: u32 = 1;
+pub const QUANTILE_INTERP_HIGHER: u32 = 2;
+pub const QUANTILE_INTERP_MIDPOINT: u32 = 3;
+pub const QUANTILE_INTERP_LINEAR: u32 = 4;
+
+/// Arguments for quantile operations
+#[repr(C)]
+pub struct QuantileArgs {
+    pub quantiles: *const f64, // Quantiles in [0, 1]
+    pub count: usize,          // Number of quantiles (1 for a single quantile)
+    pub interp: u32,           // QUANTILE_INTERP_* constant
+}
+
+impl QuantileArgs {
+    /// Decode the interpolation method
+    pub fn interpolation(&self) -> Result<QuantileInterpolOptions, FfiResult> {
+        match self.interp {
+            QUANTILE_INTERP_NEAREST => Ok(QuantileInterpolOptions::Nearest),
+            QUANTILE_INTERP_LOWER => Ok(QuantileInterpolOptions::Lower),
+            QUANTILE_INTERP_HIGHER => Ok(QuantileInterpolOptions::Higher),
+            QUANTILE_INTERP_MIDPOINT => Ok(QuantileInterpolOptions::Midpoint),
+            QUANTILE_INTERP_LINEAR => Ok(QuantileInterpolOptions::Linear),
+            other => Err(FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!("Unknown quantile interpolation {}", other),
+            )),
+        }
+    }
+
+    /// Read and validate the quantile values
+    pub fn values(&self) -> Result<&[f64], FfiResult> {
+        if self.quantiles.is_null() || self.count == 0 {
+            return Err(FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                "quantile requires at least one value",
+            ));
+        }
+        let values = unsafe { std::slice::from_raw_parts(self.quantiles, self.count) };
+        if let Some(q) = values.iter().find(|q| !(0.0..=1.0).contains(*q)) {
+            return Err(FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                &format!("quantile {} is outside [0, 1]", q),
+            ));
+        }
+        Ok(values)
+    }
+}
+
 /// Centralized literal abstraction - C-compatible struct for various literal values
 #[repr(C)]
 pub struct Literal {
@@ -74,6 +214,79 @@ impl Literal {
     }
 }
 
+/// Arguments for set membership (IsIn) - a literal set sharing one value type
+#[repr(C)]
+pub struct IsInArgs {
+    pub values: *const Literal, // Set members
+    pub count: usize,           // Number of members
+}
+
+impl IsInArgs {
+    /// Build the literal set as a Series
+    pub fn to_series(&self) -> Result<Series, FfiResult> {
+        if self.values.is_null() || self.count == 0 {
+            return Err(FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                "is_in requires at least one value",
+            ));
+        }
+        let values = unsafe { std::slice::from_raw_parts(self.values, self.count) };
+        let value_type = values[0].value_type;
+        if values.iter().any(|v| v.value_type != value_type) {
+            return Err(FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                "is_in values must all have the same type",
+            ));
+        }
+
+        match value_type {
+            0 => Ok(Series::new(
+                "".into(),
+                values.iter().map(|v| v.int_value).collect::<Vec<_>>(),
+            )),
+            1 => Ok(Series::new(
+                "".into(),
+                values.iter().map(|v| v.float_value).collect::<Vec<_>>(),
+            )),
+            2 => {
+                let strings: std::result::Result<Vec<&str>, _> = values
+                    .iter()
+                    .map(|v| unsafe { v.string_value.as_str() })
+                    .collect();
+                match strings {
+                    Ok(strings) => Ok(Series::new("".into(), strings)),
+                    Err(_) => Err(FfiResult::error(
+                        ERROR_INVALID_UTF8,
+                        "Invalid UTF-8 in string literal",
+                    )),
+                }
+            }
+            3 => Ok(Series::new(
+                "".into(),
+                values.iter().map(|v| v.bool_value).collect::<Vec<_>>(),
+            )),
+            _ => Err(FfiResult::error(
+                ERROR_POLARS_OPERATION,
+                "Invalid literal type",
+            )),
+        }
+    }
+}
+
+/// Arguments for excluding columns from a multi-column selector
+#[repr(C)]
+pub struct ExcludeArgs {
+    pub columns: *const RawStr, // Column names to exclude
+    pub count: usize,           // Number of names
+}
+
+/// Arguments for selecting columns by data type
+#[repr(C)]
+pub struct DtypeColsArgs {
+    pub dtypes: *const u32, // Data types whose columns are selected (bit-packed encoding)
+    pub count: usize,       // Number of data types
+}
+
 /// Decode bit-packed data type from u32 to Polars DataType
 pub fn decode_data_type(encoded: u32) -> Result<DataType, FfiResult> {
     // Extract type family (high 16 bits) and variant (low 16 bits)
@@ -145,9 +358,47 @@ pub fn decode_data_type(encoded: u32) -> Result<DataType, FfiResult> {
                 )),
             }
         }
+        0x0005 => {
+            // List family - the variant packs the inner family (high byte) and variant (low byte)
+            let inner_family = variant >> 8;
+            if inner_family == 0x0005 {
+                return Err(FfiResult::error(
+                    ERROR_POLARS_OPERATION,
+                    "Nested list types are not supported",
+                ));
+            }
+            let inner = decode_data_type((inner_family << 16) | (variant & 0xFF))?;
+            Ok(DataType::List(Box::new(inner)))
+        }
+        0x0006 => {
+            // Struct family - fields are not encoded, so there is no struct to build
+            match variant {
+                0x0001 => Err(FfiResult::error(
+                    ERROR_POLARS_OPERATION,
+                    "Struct has no field list and cannot be used as a cast, override or selector dtype",
+                )),
+                _ => Err(FfiResult::error(
+                    ERROR_POLARS_OPERATION,
+                    &format!("Unknown struct type variant: {}", variant),
+                )),
+            }
+        }
         _ => Err(FfiResult::error(
             ERROR_POLARS_OPERATION,
             &format!("Unknown data type family: {}", family),
         )),
     }
 }
+
+/// Decode an array of bit-packed data types (used by dtype selectors)
+/// A null pointer or zero count yields an empty Vec
+pub unsafe fn decode_data_type_array(encoded: *const u32, count: usize) -> Result<Vec<DataType>, FfiResult> {
+    if encoded.is_null() || count == 0 {
+        return Ok(Vec::new());
+    }
+
+    std::slice::from_raw_parts(encoded, count)
+        .iter()
+        .map(|&code| decode_data_type(code))
+        .collect()
+}
@@ -0,0 +1,131 @@
+#!/usr/bin/env python3
+"""
+Generate testdata/dataset/employees_part2.parquet without third-party dependencies.
+Writes a minimal Parquet file (PLAIN encoding, uncompressed, one row group) holding
+the last three rows of testdata/sample.csv, so ReadDataset tests can mix formats.
+
+Usage:
+  python3 generate_dataset_parquet.py ../testdata/dataset/employees_part2.parquet
+"""
+
+import struct, sys
+
+def varint(n):
+    out = bytearray()
+    while True:
+        b = n & 0x7F
+        n >>= 7
+        if n:
+            out.append(b | 0x80)
+        else:
+            out.append(b)
+            return bytes(out)
+
+def zigzag(n, bits=64):
+    return (n << 1) ^ (n >> (bits - 1))
+
+I32, I64, BINARY, LIST, STRUCT = 5, 6, 8, 9, 12
+
+class W:
+    def __init__(self):
+        self.buf = bytearray()
+        self.last = [0]
+    def field(self, fid, ftype):
+        delta = fid - self.last[-1]
+        if 0 < delta <= 15:
+            self.buf.append((delta << 4) | ftype)
+        else:
+            self.buf.append(ftype)
+            self.buf += varint(zigzag(fid, 16))
+        self.last[-1] = fid
+    def i32(self, fid, v):
+        self.field(fid, I32); self.buf += varint(zigzag(v, 32))
+    def i64(self, fid, v):
+        self.field(fid, I64); self.buf += varint(zigzag(v, 64))
+    def string(self, fid, s):
+        self.field(fid, BINARY); self.raw_string(s)
+    def raw_string(self, s):
+        b = s.encode(); self.buf += varint(len(b)); self.buf += b
+    def begin_struct(self, fid=None):
+        if fid is not None:
+            self.field(fid, STRUCT)
+        self.last.append(0)
+    def end_struct(self):
+        self.buf.append(0); self.last.pop()
+    def list_header(self, fid, etype, size):
+        self.field(fid, LIST)
+        if size < 15:
+            self.buf.append((size << 4) | etype)
+        else:
+            self.buf.append(0xF0 | etype); self.buf += varint(size)
+
+def plain(values, ptype):
+    out = bytearray()
+    for v in values:
+        if ptype == 'int64':
+            out += struct.pack('<q', v)
+        else:
+            b = v.encode(); out += struct.pack('<I', len(b)) + b
+    return bytes(out)
+
+def write(path, columns):
+    # columns: list of (name, ptype, values); all REQUIRED, PLAIN, UNCOMPRESSED
+    nrows = len(columns[0][2])
+    body = bytearray(b'PAR1')
+    chunks = []
+    for name, ptype, values in columns:
+        data = plain(values, ptype)
+        h = W()
+        h.i32(1, 0)  # DATA_PAGE
+        h.i32(2, len(data)); h.i32(3, len(data))
+        h.begin_struct(5)
+        h.i32(1, nrows); h.i32(2, 0); h.i32(3, 3); h.i32(4, 3)
+        h.end_struct()
+        h.buf.append(0)
+        offset = len(body)
+        body += h.buf + data
+        chunks.append((name, ptype, offset, len(h.buf) + len(data)))
+
+    m = W()
+    m.i32(1, 1)
+    m.list_header(2, STRUCT, len(columns) + 1)
+    m.begin_struct(); m.string(4, 'schema'); m.i32(5, len(columns)); m.end_struct()
+    for name, ptype, _ in columns:
+        m.begin_struct()
+        m.i32(1, 2 if ptype == 'int64' else 6)
+        m.i32(3, 0)
+        m.string(4, name)
+        if ptype == 'string':
+            m.i32(6, 0)  # UTF8
+        m.end_struct()
+    m.i64(3, nrows)
+    m.list_header(4, STRUCT, 1)
+    m.begin_struct()
+    m.list_header(1, STRUCT, len(chunks))
+    for name, ptype, offset, size in chunks:
+        m.begin_struct()
+        m.i64(2, offset)
+        m.begin_struct(3)
+        m.i32(1, 2 if ptype == 'int64' else 6)
+        m.list_header(2, I32, 1); m.buf += varint(zigzag(0, 32))
+        m.list_header(3, BINARY, 1); m.raw_string(name)
+        m.i32(4, 0)
+        m.i64(5, nrows); m.i64(6, size); m.i64(7, size)
+        m.i64(9, offset)
+        m.end_struct()
+        m.end_struct()
+    m.i64(2, sum(c[3] for c in chunks))
+    m.i64(3, nrows)
+    m.end_struct()
+    m.string(6, 'firn testdata')
+    m.buf.append(0)
+
+    body += m.buf + struct.pack('<I', len(m.buf)) + b'PAR1'
+    open(path, 'wb').write(body)
+
+write(sys.argv[1], [
+    ('name', 'string', ['Eve', 'Frank', 'Grace']),
+    ('age', 'int64', [32, 29, 27]),
+    ('salary', 'int64', [65000, 58000, 52000]),
+    ('department', 'string', ['Engineering', 'Marketing', 'Sales']),
+])